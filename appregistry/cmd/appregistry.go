@@ -1,16 +1,31 @@
 package cmd
 
 import (
+	"context"
 	"os"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
 	"github.com/muesli/termenv"
 	"github.com/spf13/cobra"
+
+	"github.com/ignite/apps/appregistry/pkg/xgithub"
+	"github.com/ignite/apps/appregistry/registry"
 )
 
 const (
 	flagGithubToken = "github-token"
 	flagBranch      = "branch"
+	flagOffline     = "offline"
+	flagSource      = "source"
+	flagNoDefault   = "no-default-source"
+	flagFormat      = "format"
+)
+
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatSARIF = "sarif"
 )
 
 // NewAppRegistry creates a new app registry command that holds
@@ -30,13 +45,22 @@ so it's recommended to use the --github-token flag you want to use appregistry c
 
 	c.AddCommand(
 		NewListCmd(),
+		NewSearchCmd(),
 		NewDetailsCmd(),
 		NewValidateCmd(),
+		NewInitCmd(),
 		NewInstallCmd(),
+		NewUninstallCmd(),
+		NewOutdatedCmd(),
+		NewUpgradeCmd(),
+		NewSyncCmd(),
 	)
 
 	c.PersistentFlags().String(flagGithubToken, "", "GitHub access token")
 	c.PersistentFlags().StringP(flagBranch, "b", "main", "The app branch to use")
+	c.PersistentFlags().Bool(flagOffline, false, "Read the app registry only from the local index without network access")
+	c.PersistentFlags().StringArray(flagSource, nil, "Registry source by priority order, overrides the sources config file (github:<owner>/<repo>[/<path>], local:<path> or http(s)://<url>)")
+	c.PersistentFlags().Bool(flagNoDefault, false, "Don't add the official Ignite App Registry after the configured sources")
 
 	return c
 }
@@ -48,6 +72,11 @@ func getBranchFlag(cmd *cobra.Command) string {
 	return "main"
 }
 
+func getOfflineFlag(cmd *cobra.Command) bool {
+	offline, _ := cmd.Flags().GetBool(flagOffline)
+	return offline
+}
+
 func getGitHubToken(cmd *cobra.Command) string {
 	if githubToken, _ := cmd.Flags().GetString(flagGithubToken); githubToken != "" {
 		return githubToken
@@ -58,6 +87,71 @@ func getGitHubToken(cmd *cobra.Command) string {
 	return ""
 }
 
+// getSourcesConfig returns the registry sources from the --source flags or
+// from the sources config file.
+func getSourcesConfig(cmd *cobra.Command) (registry.SourcesConfig, error) {
+	var (
+		flagSources, _ = cmd.Flags().GetStringArray(flagSource)
+		noDefault, _   = cmd.Flags().GetBool(flagNoDefault)
+	)
+	if len(flagSources) == 0 {
+		path, err := registry.DefaultSourcesConfigPath()
+		if err != nil {
+			return registry.SourcesConfig{}, err
+		}
+		cfg, err := registry.LoadSourcesConfig(path)
+		if err != nil {
+			return registry.SourcesConfig{}, err
+		}
+		cfg.ExcludeDefault = cfg.ExcludeDefault || noDefault
+		return cfg, nil
+	}
+
+	cfg := registry.SourcesConfig{ExcludeDefault: noDefault}
+	for _, s := range flagSources {
+		source, err := registry.ParseSourceConfig(s)
+		if err != nil {
+			return registry.SourcesConfig{}, err
+		}
+		cfg.Sources = append(cfg.Sources, source)
+	}
+	return cfg, nil
+}
+
+// newRegistryQuerier creates a registry querier configured from the command flags.
+func newRegistryQuerier(cmd *cobra.Command) (*registry.Querier, error) {
+	client := xgithub.NewClient(getGitHubToken(cmd))
+
+	sourcesConfig, err := getSourcesConfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := registry.NewSources(client, sourcesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []registry.QuerierOption{registry.WithSources(sources...)}
+	if getOfflineFlag(cmd) {
+		options = append(options, registry.WithOffline())
+	}
+	return registry.NewRegistryQuerier(client, options...), nil
+}
+
+type clientAPIKey struct{}
+
+// WithClientAPI returns a copy of the context carrying the Ignite client API.
+func WithClientAPI(ctx context.Context, api plugin.ClientAPI) context.Context {
+	return context.WithValue(ctx, clientAPIKey{}, api)
+}
+
+// clientAPIFromContext returns the Ignite client API from the context, if any.
+func clientAPIFromContext(ctx context.Context) (plugin.ClientAPI, bool) {
+	api, ok := ctx.Value(clientAPIKey{}).(plugin.ClientAPI)
+	return api, ok && api != nil
+}
+
 func init() {
 	lipgloss.SetColorProfile(termenv.TrueColor)
 }
@@ -8,9 +8,6 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/spf13/cobra"
-
-	"github.com/ignite/apps/appregistry/pkg/xgithub"
-	"github.com/ignite/apps/appregistry/registry"
 )
 
 var (
@@ -39,16 +36,15 @@ func NewDetailsCmd() *cobra.Command {
 }
 
 func detailsHandler(cmd *cobra.Command, args []string) error {
-	var (
-		githubToken = getGitHubToken(cmd)
-		branch      = getBranchFlag(cmd)
-	)
+	branch := getBranchFlag(cmd)
 
 	session := cliui.New(cliui.StartSpinnerWithText("🔎 Fetching repository details from GitHub..."))
 	defer session.End()
 
-	client := xgithub.NewClient(githubToken)
-	registryQuerier := registry.NewRegistryQuerier(client)
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
 
 	appDetails, err := registryQuerier.GetAppDetails(cmd.Context(), args[0], branch)
 	if err != nil {
@@ -71,6 +67,7 @@ func detailsHandler(cmd *cobra.Command, args []string) error {
 	printItem("Ignite version", appDetails.App.IgniteVersion)
 	printItem("Documentation", appDetails.App.DocumentationURL)
 	printItem("Repository", linkStyle.Render(appDetails.URL))
+	printItem("Source", appDetails.App.Source)
 
 	fmt.Fprintln(w, installationStyle.Render(fmt.Sprintf(
 		"🚀 Install via: %s", commandStyle.Render(fmt.Sprintf("ignite app -g install %s", appDetails.App.PackageURL)),
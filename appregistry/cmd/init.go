@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/bubbleconfirm"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/apps/appregistry/registry"
+)
+
+const (
+	flagPath       = "path"
+	flagRepository = "repository"
+	flagAppID      = "app-id"
+	flagOutput     = "output"
+	flagYes        = "yes"
+)
+
+// appQuestion is a prompt filling an app entry field.
+type appQuestion struct {
+	// text is the question asked to the user.
+	text string
+	// field is the app JSON field validated after the answer, if any.
+	field string
+	get   func(registry.App) string
+	set   func(*registry.App, string)
+}
+
+// NewInitCmd creates a new init command that scaffolds a new app registry entry.
+func NewInitCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "init",
+		Short: "Scaffold a new app registry entry",
+		Long: `Scaffold a new app registry entry interactively.
+The answers are prefilled from the go.mod and app.ignite.yml files of the app
+repository and from the GitHub repository metadata, and validated with the same
+rules as the validate command. The entry is written into the registry directory
+following the <owner>.<repository>.<app id>.json naming rule.`,
+		Args: cobra.NoArgs,
+		RunE: initHandler,
+	}
+
+	c.Flags().String(flagPath, ".", "Path of the local app repository used to prefill the entry")
+	c.Flags().String(flagRepository, "", "GitHub repository URL of the app (default to the go.mod module path)")
+	c.Flags().String(flagAppID, "", "App id from the app.ignite.yml file (default to the first app)")
+	c.Flags().StringP(flagOutput, "o", "_registry", "Registry directory where the entry is written")
+	c.Flags().BoolP(flagYes, "y", false, "Use the prefilled answers without prompting")
+
+	return c
+}
+
+func initHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		dir, _     = cmd.Flags().GetString(flagPath)
+		repoURL, _ = cmd.Flags().GetString(flagRepository)
+		appID, _   = cmd.Flags().GetString(flagAppID)
+		output, _  = cmd.Flags().GetString(flagOutput)
+		yes, _     = cmd.Flags().GetBool(flagYes)
+	)
+
+	session := cliui.New(cliui.WithStdout(os.Stdout))
+	defer session.End()
+
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
+
+	app, err := registryQuerier.PrefillApp(cmd.Context(), dir, repoURL, appID)
+	if err != nil {
+		return err
+	}
+
+	for _, q := range appQuestions() {
+		for {
+			answer := q.get(app)
+			if !yes {
+				options := []bubbleconfirm.Option{}
+				if answer != "" {
+					options = append(options, bubbleconfirm.DefaultAnswer(answer))
+				}
+				if err := session.Ask(bubbleconfirm.NewQuestion(q.text, &answer, options...)); err != nil {
+					return err
+				}
+			}
+			q.set(&app, strings.TrimSpace(answer))
+
+			if q.field == "" {
+				break
+			}
+			err := app.ValidateField(q.field)
+			if err == nil {
+				break
+			}
+			if yes {
+				return err
+			}
+			if err := session.Printf("❌ %s\n", err); err != nil {
+				return err
+			}
+		}
+	}
+
+	owner, repo, err := registry.RepositoryOwnerAndName(app.RepositoryURL.String())
+	if err != nil {
+		return err
+	}
+	fileName := registry.AppFileName(owner, repo, app.AppID.String())
+	app.Namespace = strings.TrimSuffix(fileName, filepath.Ext(fileName))
+
+	if err := app.Validate(); err != nil {
+		return err
+	}
+	if err := registry.ValidateAppFileName(fileName); err != nil {
+		if err := session.Printf("⚠️  %s, the entry won't be listed by the app registry\n", err); err != nil {
+			return err
+		}
+	}
+
+	filePath := filepath.Join(output, fileName)
+	if _, err := os.Stat(filePath); err == nil && !yes {
+		if err := session.AskConfirm(filePath + " already exists. Do you want to overwrite it?"); err != nil {
+			return err
+		}
+	}
+
+	filePath, err = registry.WriteAppFile(output, fileName, app)
+	if err != nil {
+		return err
+	}
+	return session.Printf("🎉 App registry entry created at %s\n", filePath)
+}
+
+// appQuestions returns the questions filling the app entry fields.
+func appQuestions() []appQuestion {
+	return []appQuestion{
+		{
+			text:  "App name (upper camel case)",
+			field: "appName",
+			get:   func(a registry.App) string { return a.Name.String() },
+			set:   func(a *registry.App, v string) { a.Name = registry.Field(v) },
+		},
+		{
+			text:  "App id (kebab case)",
+			field: "appID",
+			get:   func(a registry.App) string { return a.AppID.String() },
+			set:   func(a *registry.App, v string) { a.AppID = registry.Field(v) },
+		},
+		{
+			text:  "App description",
+			field: "appDescription",
+			get:   func(a registry.App) string { return a.Description.String() },
+			set:   func(a *registry.App, v string) { a.Description = registry.Field(v) },
+		},
+		{
+			text:  "Ignite version constraint",
+			field: "ignite",
+			get:   func(a registry.App) string { return a.Ignite.String() },
+			set:   func(a *registry.App, v string) { a.Ignite = registry.Version(v) },
+		},
+		{
+			text:  "Cosmos SDK version constraint",
+			field: "cosmosSDK",
+			get:   func(a registry.App) string { return a.CosmosSDK.String() },
+			set:   func(a *registry.App, v string) { a.CosmosSDK = registry.Version(v) },
+		},
+		{
+			text:  "Repository URL",
+			field: "repositoryUrl",
+			get:   func(a registry.App) string { return a.RepositoryURL.String() },
+			set:   func(a *registry.App, v string) { a.RepositoryURL = registry.URL(v) },
+		},
+		{
+			text:  "Documentation URL",
+			field: "documentationUrl",
+			get:   func(a registry.App) string { return a.DocumentationURL.String() },
+			set:   func(a *registry.App, v string) { a.DocumentationURL = registry.URL(v) },
+		},
+		{
+			text: "License name",
+			get:  func(a registry.App) string { return a.License.Name.String() },
+			set:  func(a *registry.App, v string) { a.License.Name = registry.Field(v) },
+		},
+		{
+			text:  "License URL",
+			field: "license",
+			get:   func(a registry.App) string { return a.License.URL.String() },
+			set:   func(a *registry.App, v string) { a.License.URL = registry.URL(v) },
+		},
+		{
+			text:  "Author name",
+			field: "authors",
+			get:   func(a registry.App) string { return firstAuthor(a).Name.String() },
+			set: func(a *registry.App, v string) {
+				author := firstAuthor(*a)
+				author.Name = registry.Field(v)
+				a.Authors = registry.Authors{author}
+			},
+		},
+		{
+			text:  "Author email (optional)",
+			field: "authors",
+			get:   func(a registry.App) string { return string(firstAuthor(a).Email) },
+			set: func(a *registry.App, v string) {
+				author := firstAuthor(*a)
+				author.Email = registry.Email(v)
+				a.Authors = registry.Authors{author}
+			},
+		},
+		{
+			text:  "Keywords (comma separated)",
+			field: "keywords",
+			get:   func(a registry.App) string { return strings.Join(a.Keywords, ", ") },
+			set:   func(a *registry.App, v string) { a.Keywords = splitList(v) },
+		},
+		{
+			text:  "Supported platforms (comma separated)",
+			field: "supportedPlatforms",
+			get:   func(a registry.App) string { return strings.Join(a.SupportedPlatforms, ", ") },
+			set:   func(a *registry.App, v string) { a.SupportedPlatforms = splitList(v) },
+		},
+	}
+}
+
+func firstAuthor(a registry.App) registry.Author {
+	if len(a.Authors) == 0 {
+		return registry.Author{}
+	}
+	return a.Authors[0]
+}
+
+// splitList splits a comma separated list, ignoring the empty items.
+func splitList(s string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -1,55 +1,144 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	ignitecmd "github.com/ignite/cli/v29/ignite/cmd"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 
-	"github.com/ignite/apps/appregistry/pkg/xgithub"
 	"github.com/ignite/apps/appregistry/registry"
 )
 
+const flagForce = "force"
+
 func NewInstallCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "install [app id]",
+	c := &cobra.Command{
+		Use:   "install [app id][@version]",
 		Short: "Install an ignite app by app id",
-		Args:  cobra.ExactArgs(1),
-		RunE:  installHandler,
+		Long: `Install an ignite app by app id.
+A released version of the app can be pinned with <app id>@<version>, where the
+version is resolved from the app repository tags (e.g. explorer@v0.2.0 or
+explorer@latest). Without version, the head of the app branch is installed.
+Before the installation, the app requirements (Ignite, Go and Cosmos SDK versions)
+are checked against the running Ignite CLI, the local Go toolchain and the current chain.
+Incompatible apps are not installed unless the --force flag is used.`,
+		Args: cobra.ExactArgs(1),
+		RunE: installHandler,
 	}
+
+	c.Flags().Bool(flagForce, false, "Install the app even if it is not compatible with the current environment")
+
+	return c
 }
 
 func installHandler(cmd *cobra.Command, args []string) error {
 	var (
-		githubToken = getGitHubToken(cmd)
-		branch      = getBranchFlag(cmd)
+		branch   = getBranchFlag(cmd)
+		force, _ = cmd.Flags().GetBool(flagForce)
 	)
 
 	session := cliui.New(cliui.WithStdout(os.Stdout))
 	defer session.End()
 
-	client := xgithub.NewClient(githubToken)
-	registryQuerier := registry.NewRegistryQuerier(client)
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
 
-	appDetails, err := registryQuerier.GetAppDetails(cmd.Context(), args[0], branch)
+	appID, version := registry.SplitAppRef(args[0])
+	appDetails, err := registryQuerier.GetAppDetails(cmd.Context(), appID, branch)
 	if err != nil {
 		return err
 	}
 
+	app := appDetails.App
+	installPath := app.PackageURL
+	if version != "" {
+		versions, err := registryQuerier.ListAppVersions(cmd.Context(), appDetails.App)
+		if err != nil {
+			return err
+		}
+		appVersion, err := registry.ResolveAppVersion(versions, version)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve version of app %s", appID)
+		}
+		// check the compatibility of the pinned release instead of the branch head.
+		app, err = registryQuerier.AppDetailsAtVersion(cmd.Context(), appDetails.App, appVersion)
+		if err != nil {
+			return err
+		}
+		installPath = registry.JoinAppRef(appDetails.App.PackageURL, appVersion.Tag)
+		if err := session.Printf("📌 Pinning %s to version %s\n", appDetails.App.Name, appVersion.Version.Original()); err != nil {
+			return err
+		}
+	}
+
+	if err := checkAppCompatibility(cmd.Context(), session, app, force); err != nil {
+		return err
+	}
+
 	if !strings.HasPrefix(appDetails.App.PackageURL, fmt.Sprintf("github.com/%s/%s", registry.IgniteGitHubOrg, registry.IgniteAppsRepo)) {
 		if err := session.AskConfirm("You are about to install an app from the Ignite App Registry that is not maintained by Ignite. Do you want to continue?"); err != nil {
 			return err
 		}
 	}
 
+	return installApp(cmd.Context(), installPath)
+}
+
+// checkAppCompatibility prints the compatibility report of the app with the current
+// environment and returns an error if the app is not compatible, unless forced.
+func checkAppCompatibility(ctx context.Context, session *cliui.Session, app registry.AppDetails, force bool) error {
+	report := registry.CheckCompatibility(app, getEnvironment(ctx))
+	if err := session.Printf("🔎 Compatibility of %s:\n%s", app.Name, report); err != nil {
+		return err
+	}
+	if report.Compatible() {
+		return nil
+	}
+	if !force {
+		return errors.Errorf("app %s is not compatible with the current environment, use --%s to install it anyway", app.AppID, flagForce)
+	}
+	return session.Println("⚠️  Installing an incompatible app because of --force")
+}
+
+// installApp installs the app globally.
+func installApp(ctx context.Context, path string) error {
 	// here we are using the ignite app install command to install the app
 	// we do this in order to not duplicate logic.
 	igniteAppInstallCmd := ignitecmd.NewAppInstall()
-	igniteAppInstallCmd.SetArgs([]string{"-g", appDetails.App.PackageURL})
+	igniteAppInstallCmd.SetArgs([]string{"-g", path})
+
+	return igniteAppInstallCmd.ExecuteContext(ctx)
+}
+
+// getEnvironment returns the versions of the running Ignite CLI, the local
+// Go toolchain and the current chain Cosmos SDK. Versions that can't be
+// found are left empty and the related compatibility checks are skipped,
+// e.g. the Cosmos SDK version when the command doesn't run inside a chain.
+func getEnvironment(ctx context.Context) registry.Environment {
+	var env registry.Environment
+	if api, ok := clientAPIFromContext(ctx); ok {
+		if info, err := api.GetIgniteInfo(ctx); err == nil {
+			env.IgniteVersion = info.CliVersion
+		}
+		if chain, err := api.GetChainInfo(ctx); err == nil && chain.AppPath != "" {
+			if version, err := registry.ChainCosmosSDKVersion(chain.AppPath); err == nil {
+				env.CosmosSDKVersion = version
+			}
+		}
+	}
+
+	if out, err := exec.CommandContext(ctx, "go", "env", "GOVERSION").Output(); err == nil {
+		env.GoVersion = strings.TrimSpace(string(out))
+	}
 
-	return igniteAppInstallCmd.ExecuteContext(cmd.Context())
+	return env
 }
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	ignitecmd "github.com/ignite/cli/v29/ignite/cmd"
+	pluginsconfig "github.com/ignite/cli/v29/ignite/config/plugins"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/appregistry/registry"
+)
+
+// appUpgrade represents an installed app and its latest released version.
+type appUpgrade struct {
+	installed registry.InstalledApp
+	details   registry.AppDetails
+	versions  []registry.AppVersion
+	current   *registry.AppVersion
+	latest    *registry.AppVersion
+}
+
+// outdated returns true if the installed app is not pinned to the latest released version.
+func (u appUpgrade) outdated() bool {
+	if u.latest == nil {
+		return false
+	}
+	return u.current == nil || u.latest.Version.GreaterThan(u.current.Version)
+}
+
+// currentVersion returns the installed version or the reference when it is not a released version.
+func (u appUpgrade) currentVersion() string {
+	switch {
+	case u.current != nil:
+		return u.current.Version.Original()
+	case u.installed.Ref != "":
+		return u.installed.Ref
+	default:
+		return "unpinned"
+	}
+}
+
+// latestVersion returns the latest released version.
+func (u appUpgrade) latestVersion() string {
+	if u.latest == nil {
+		return "no release"
+	}
+	return u.latest.Version.Original()
+}
+
+// globalAppPaths returns the paths of the apps installed globally.
+func globalAppPaths() ([]string, error) {
+	dir, err := plugin.PluginsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := pluginsconfig.ParseDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the ignite apps config")
+	}
+
+	paths := make([]string, 0, len(cfg.Apps))
+	for _, app := range cfg.Apps {
+		paths = append(paths, app.Path)
+	}
+	return paths, nil
+}
+
+// installedApps returns the registry apps installed globally.
+func installedApps(ctx context.Context, registryQuerier *registry.Querier, branch string) ([]registry.InstalledApp, error) {
+	paths, err := globalAppPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := registryQuerier.List(ctx, branch)
+	if err != nil {
+		return nil, err
+	}
+	return apps.MatchInstalled(paths), nil
+}
+
+// findInstalledApp returns the installed registry app by app id.
+func findInstalledApp(installed []registry.InstalledApp, appID string) (registry.InstalledApp, error) {
+	for _, app := range installed {
+		if strings.EqualFold(app.App.AppID.String(), appID) {
+			return app, nil
+		}
+	}
+	return registry.InstalledApp{}, errors.Errorf("app %s is not installed", appID)
+}
+
+// checkUpgrade resolves the installed and the latest released versions of an app.
+func checkUpgrade(ctx context.Context, registryQuerier *registry.Querier, installed registry.InstalledApp, branch string) (appUpgrade, error) {
+	appDetails, err := registryQuerier.GetAppDetails(ctx, installed.App.AppID.String(), branch)
+	if err != nil {
+		return appUpgrade{}, err
+	}
+
+	versions, err := registryQuerier.ListAppVersions(ctx, appDetails.App)
+	if err != nil {
+		return appUpgrade{}, err
+	}
+
+	upgrade := appUpgrade{installed: installed, details: appDetails.App, versions: versions}
+	if latest, err := registry.ResolveAppVersion(versions, registry.LatestVersion); err == nil {
+		upgrade.latest = &latest
+	}
+	if current := installed.InstalledVersion(versions); current != nil {
+		upgrade.current = &registry.AppVersion{Version: current, Tag: installed.Ref}
+	}
+	return upgrade, nil
+}
+
+// uninstallApp uninstalls the global app.
+func uninstallApp(ctx context.Context, path string) error {
+	// use the ignite app uninstall command to not duplicate logic,
+	// like for the app installation.
+	igniteAppUninstallCmd := ignitecmd.NewAppUninstall()
+	igniteAppUninstallCmd.SetArgs([]string{"-g", path})
+
+	return igniteAppUninstallCmd.ExecuteContext(ctx)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/apps/appregistry/pkg/tree"
-	"github.com/ignite/apps/appregistry/pkg/xgithub"
 	"github.com/ignite/apps/appregistry/registry"
 )
 
@@ -25,16 +24,15 @@ func NewListCmd() *cobra.Command {
 }
 
 func listHandler(cmd *cobra.Command, _ []string) error {
-	var (
-		githubToken = getGitHubToken(cmd)
-		branch      = getBranchFlag(cmd)
-	)
+	branch := getBranchFlag(cmd)
 
 	session := cliui.New(cliui.StartSpinnerWithText("🔎 Searching for ignite apps on app registry..."))
 	defer session.End()
 
-	client := xgithub.NewClient(githubToken)
-	registryQuerier := registry.NewRegistryQuerier(client)
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
 
 	apps, err := registryQuerier.List(cmd.Context(), branch)
 	if err != nil {
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/spf13/cobra"
+)
+
+// NewOutdatedCmd creates a new outdated command that lists the installed apps with a newer release.
+func NewOutdatedCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "outdated",
+		Short: "List the installed ignite apps not pinned to their latest release",
+		Args:  cobra.NoArgs,
+		RunE:  outdatedHandler,
+	}
+}
+
+func outdatedHandler(cmd *cobra.Command, _ []string) error {
+	branch := getBranchFlag(cmd)
+
+	session := cliui.New(cliui.StartSpinnerWithText("🔎 Checking installed ignite apps..."))
+	defer session.End()
+
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
+
+	installed, err := installedApps(cmd.Context(), registryQuerier, branch)
+	if err != nil {
+		return err
+	}
+
+	upgrades := make([]appUpgrade, 0)
+	for _, app := range installed {
+		upgrade, err := checkUpgrade(cmd.Context(), registryQuerier, app, branch)
+		if err != nil {
+			return err
+		}
+		if upgrade.outdated() {
+			upgrades = append(upgrades, upgrade)
+		}
+	}
+
+	session.StopSpinner()
+
+	if len(upgrades) == 0 {
+		return session.Println("✅ All the installed ignite apps are up to date")
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "APP\tINSTALLED\tLATEST")
+	for _, upgrade := range upgrades {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", upgrade.installed.App.AppID, upgrade.currentVersion(), upgrade.latestVersion())
+	}
+	return w.Flush()
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/apps/appregistry/registry"
+)
+
+const (
+	flagPlatform  = "platform"
+	flagIgnite    = "ignite"
+	flagCosmosSDK = "cosmos-sdk"
+	flagLicense   = "license"
+	flagAuthor    = "author"
+	flagJSON      = "json"
+)
+
+// NewSearchCmd creates a new search command that searches ignite apps in the app registry.
+func NewSearchCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "search [query]",
+		Short: "Search ignite apps in the app registry",
+		Long: `Search ignite apps by name, description and keywords.
+The results are ranked by relevance and can be filtered by platform, Ignite
+version, Cosmos SDK version, license and author.`,
+		Example: `  ignite appregistry search relayer
+  ignite appregistry search --platform linux --ignite v29.0.0
+  ignite appregistry search explorer --json`,
+		Args: cobra.ArbitraryArgs,
+		RunE: searchHandler,
+	}
+
+	c.Flags().String(flagPlatform, "", "Filter apps by supported platform (e.g. mac, linux)")
+	c.Flags().String(flagIgnite, "", "Filter apps compatible with the Ignite version")
+	c.Flags().String(flagCosmosSDK, "", "Filter apps compatible with the Cosmos SDK version")
+	c.Flags().String(flagLicense, "", "Filter apps by license name")
+	c.Flags().String(flagAuthor, "", "Filter apps by author name")
+	c.Flags().Bool(flagJSON, false, "Print the results as JSON")
+
+	return c
+}
+
+func searchHandler(cmd *cobra.Command, args []string) error {
+	var (
+		branch        = getBranchFlag(cmd)
+		platform, _   = cmd.Flags().GetString(flagPlatform)
+		ignite, _     = cmd.Flags().GetString(flagIgnite)
+		cosmosSDK, _  = cmd.Flags().GetString(flagCosmosSDK)
+		license, _    = cmd.Flags().GetString(flagLicense)
+		author, _     = cmd.Flags().GetString(flagAuthor)
+		jsonOutput, _ = cmd.Flags().GetBool(flagJSON)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText("🔎 Searching for ignite apps on app registry..."))
+	defer session.End()
+
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
+
+	apps, err := registryQuerier.List(cmd.Context(), branch)
+	if err != nil {
+		return err
+	}
+
+	results := apps.Search(registry.SearchOptions{
+		Query:     strings.Join(args, " "),
+		Platform:  platform,
+		Ignite:    ignite,
+		CosmosSDK: cosmosSDK,
+		License:   license,
+		Author:    author,
+	})
+
+	session.StopSpinner()
+
+	if jsonOutput {
+		out, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		return session.Println(string(out))
+	}
+
+	if len(results) == 0 {
+		return session.Println("❌ No ignite application matches the search")
+	}
+
+	found := make([]registry.App, 0, len(results))
+	for _, result := range results {
+		found = append(found, result.App)
+	}
+	return session.Print(formatAppsTree(found))
+}
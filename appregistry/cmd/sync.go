@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/spf13/cobra"
+)
+
+// NewSyncCmd creates a new sync command that refreshes the local app registry index.
+func NewSyncCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync",
+		Short: "Refresh the local index of the app registry",
+		Long: `Fetch all the ignite apps from the app registry and store them into the local index.
+The local index is used by the other commands to avoid hitting the GitHub API rate limit
+and by the --offline flag to browse the app registry without network access.`,
+		Args: cobra.NoArgs,
+		RunE: syncHandler,
+	}
+}
+
+func syncHandler(cmd *cobra.Command, _ []string) error {
+	branch := getBranchFlag(cmd)
+
+	session := cliui.New(cliui.StartSpinnerWithText("🔄 Syncing the app registry index..."))
+	defer session.End()
+
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
+
+	indexes, err := registryQuerier.Sync(cmd.Context(), branch)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	for _, index := range indexes {
+		if err := session.Printf(
+			"✅ Synced %d apps from source %s (branch %s, revision %s)\n",
+			len(index.Apps),
+			index.Source,
+			index.Branch,
+			shortRevision(index.Revision),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func shortRevision(revision string) string {
+	if len(revision) > 12 {
+		return revision[:12]
+	}
+	return revision
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/spf13/cobra"
+)
+
+// NewUninstallCmd creates a new uninstall command that uninstalls an ignite app by app id.
+func NewUninstallCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "uninstall [app id]",
+		Short: "Uninstall an ignite app by app id",
+		Args:  cobra.ExactArgs(1),
+		RunE:  uninstallHandler,
+	}
+}
+
+func uninstallHandler(cmd *cobra.Command, args []string) error {
+	branch := getBranchFlag(cmd)
+
+	session := cliui.New(cliui.WithStdout(os.Stdout))
+	defer session.End()
+
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
+
+	installed, err := installedApps(cmd.Context(), registryQuerier, branch)
+	if err != nil {
+		return err
+	}
+
+	app, err := findInstalledApp(installed, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := uninstallApp(cmd.Context(), app.Path); err != nil {
+		return err
+	}
+	return session.Printf("🗑️  Uninstalled %s (%s)\n", app.App.AppID, app.Path)
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/apps/appregistry/registry"
+)
+
+// NewUpgradeCmd creates a new upgrade command that upgrades the installed apps to a released version.
+func NewUpgradeCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade [app id][@version]...",
+		Short: "Upgrade installed ignite apps to a released version",
+		Long: `Upgrade installed ignite apps to a released version.
+Without arguments, all the outdated apps are upgraded to their latest release,
+the apps without release or incompatible with the current environment are skipped.
+A specific version can be set with <app id>@<version>.
+If the new version can't be installed, the previous version is installed again.`,
+		Args: cobra.ArbitraryArgs,
+		RunE: upgradeHandler,
+	}
+
+	c.Flags().Bool(flagForce, false, "Upgrade the apps even if they are not compatible with the current environment")
+
+	return c
+}
+
+func upgradeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		branch   = getBranchFlag(cmd)
+		force, _ = cmd.Flags().GetBool(flagForce)
+		all      = len(args) == 0
+	)
+
+	session := cliui.New(cliui.WithStdout(os.Stdout))
+	defer session.End()
+
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
+
+	installed, err := installedApps(cmd.Context(), registryQuerier, branch)
+	if err != nil {
+		return err
+	}
+
+	// targets maps the installed apps to the requested version.
+	type target struct {
+		app     registry.InstalledApp
+		version string
+	}
+	targets := make([]target, 0)
+	if all {
+		for _, app := range installed {
+			targets = append(targets, target{app: app, version: registry.LatestVersion})
+		}
+	}
+	for _, arg := range args {
+		appID, version := registry.SplitAppRef(arg)
+		app, err := findInstalledApp(installed, appID)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, target{app: app, version: version})
+	}
+
+	// skip reports the apps that can't be upgraded when upgrading all the apps,
+	// an explicitly requested upgrade fails instead.
+	skipped := 0
+	skip := func(app registry.InstalledApp, err error) error {
+		if !all {
+			return err
+		}
+		skipped++
+		return session.Printf("⏭️  Skipping %s: %s\n", app.App.AppID, err)
+	}
+
+	upgraded := 0
+	for _, t := range targets {
+		upgrade, err := checkUpgrade(cmd.Context(), registryQuerier, t.app, branch)
+		if err != nil {
+			if err := skip(t.app, err); err != nil {
+				return err
+			}
+			continue
+		}
+		version, err := registry.ResolveAppVersion(upgrade.versions, t.version)
+		if err != nil {
+			if err := skip(t.app, errors.Wrapf(err, "failed to resolve version of app %s", t.app.App.AppID)); err != nil {
+				return err
+			}
+			continue
+		}
+		if version.Tag == t.app.Ref {
+			continue
+		}
+
+		details, err := registryQuerier.AppDetailsAtVersion(cmd.Context(), upgrade.details, version)
+		if err != nil {
+			if err := skip(t.app, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := checkAppCompatibility(cmd.Context(), session, details, force); err != nil {
+			if err := skip(t.app, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := session.Printf("⬆️  Upgrading %s to %s\n", t.app.App.AppID, version.Version.Original()); err != nil {
+			return err
+		}
+		if err := upgradeApp(cmd.Context(), t.app, version); err != nil {
+			return err
+		}
+		upgraded++
+	}
+
+	if upgraded == 0 && skipped == 0 {
+		return session.Println("✅ All the installed ignite apps are up to date")
+	}
+	return nil
+}
+
+// upgradeApp replaces the installed app by the app version. Both versions can't be
+// installed at the same time, so the installed version is restored if the new one
+// fails to install.
+func upgradeApp(ctx context.Context, app registry.InstalledApp, version registry.AppVersion) error {
+	if err := uninstallApp(ctx, app.Path); err != nil {
+		return errors.Wrapf(err, "failed to uninstall %s", app.Path)
+	}
+
+	err := installApp(ctx, registry.JoinAppRef(app.PackageURL, version.Tag))
+	if err == nil {
+		return nil
+	}
+	err = errors.Wrapf(err, "failed to install %s version %s", app.App.AppID, version.Version.Original())
+	if restoreErr := installApp(ctx, app.Path); restoreErr != nil {
+		return errors.Join(err, errors.Wrapf(restoreErr, "failed to restore %s", app.Path))
+	}
+	return err
+}
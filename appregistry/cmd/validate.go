@@ -7,42 +7,80 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/spf13/cobra"
-
-	"github.com/ignite/apps/appregistry/pkg/xgithub"
-	"github.com/ignite/apps/appregistry/registry"
 )
 
 // NewValidateCmd creates a new validate command that validates the Ignite application json.
 func NewValidateCmd() *cobra.Command {
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:     "validate [app file]",
 		Aliases: []string{"v"},
 		Short:   "Validate the ignite application json",
-		Args:    cobra.ExactArgs(1),
-		RunE:    validateHandler,
+		Long: `Validate the ignite application json and the app repository.
+Besides the JSON fields, the app must be registered into the app.ignite.yml file of its
+repository, the app path must contain a go.mod and a main.go serving the ignite plugin
+interface, the icon and cover must be images and the license must match the repository
+license. Issues are reported as errors or warnings, only errors make the validation fail.`,
+		Args: cobra.ExactArgs(1),
+		RunE: validateHandler,
 	}
+
+	c.Flags().String(flagFormat, formatText, "Output format of the validation report (text, json or sarif)")
+
+	return c
 }
 
 func validateHandler(cmd *cobra.Command, args []string) error {
 	var (
-		githubToken = getGitHubToken(cmd)
-		branch      = getBranchFlag(cmd)
+		branch    = getBranchFlag(cmd)
+		format, _ = cmd.Flags().GetString(flagFormat)
 	)
+	if format != formatText && format != formatJSON && format != formatSARIF {
+		return errors.Errorf("unknown format %s, expected %s, %s or %s", format, formatText, formatJSON, formatSARIF)
+	}
 
 	session := cliui.New(cliui.StartSpinnerWithText("🔎 Fetching repository details from GitHub..."))
 	defer session.End()
 
-	client := xgithub.NewClient(githubToken)
-	registryQuerier := registry.NewRegistryQuerier(client)
+	registryQuerier, err := newRegistryQuerier(cmd)
+	if err != nil {
+		return err
+	}
 
 	absPath, err := filepath.Abs(strings.TrimSpace(args[0]))
 	if err != nil {
 		return errors.Wrapf(err, "failed to get absolute path for %s", args[0])
 	}
-	if err := registryQuerier.ValidateAppDetails(cmd.Context(), absPath, branch); err != nil {
+	report, err := registryQuerier.ValidateAppDetails(cmd.Context(), absPath, branch)
+	if err != nil {
 		return err
 	}
+	report.File = filepath.ToSlash(filepath.Clean(args[0]))
 
 	session.StopSpinner()
+
+	switch format {
+	case formatJSON, formatSARIF:
+		out, err := report.JSON()
+		if format == formatSARIF {
+			out, err = report.SARIF()
+		}
+		if err != nil {
+			return err
+		}
+		if err := session.Println(string(out)); err != nil {
+			return err
+		}
+	default:
+		if err := session.Print(report.String()); err != nil {
+			return err
+		}
+	}
+
+	if report.HasErrors() {
+		return errors.Errorf("invalid %s file", args[0])
+	}
+	if format != formatText {
+		return nil
+	}
 	return session.Printf("🚀 valid %s file\n", args[0])
 }
@@ -20,14 +20,14 @@ func (app) Manifest(context.Context) (*plugin.Manifest, error) {
 	return m, nil
 }
 
-func (app) Execute(_ context.Context, c *plugin.ExecutedCommand, _ plugin.ClientAPI) error {
+func (app) Execute(ctx context.Context, c *plugin.ExecutedCommand, api plugin.ClientAPI) error {
 	// Instead of a switch on c.Use, we run the root command like if
 	// we were in a command line context. This implies to set os.Args
 	// correctly.
 	// Remove the first arg "ignite" from OSArgs because our appregistry
 	// command root is "appregistry" not "ignite".
 	os.Args = c.OsArgs[1:]
-	return cmd.NewAppRegistry().Execute()
+	return cmd.NewAppRegistry().ExecuteContext(cmd.WithClientAPI(ctx, api))
 }
 
 func (app) ExecuteHookPre(context.Context, *plugin.ExecutedHook, plugin.ClientAPI) error {
@@ -2,6 +2,7 @@ package xgithub
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/google/go-github/v56/github"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
@@ -91,3 +92,42 @@ func (c *Client) GetFileContent(ctx context.Context, owner, repo, path string, o
 
 	return []byte(s), nil
 }
+
+// GetCommitSHA returns the commit SHA the given ref points to. If lastSHA is
+// provided, a conditional request is sent and lastSHA is returned when the
+// ref did not move. Conditional requests answered with "304 Not Modified"
+// do not count against the GitHub API rate limit.
+func (c *Client) GetCommitSHA(ctx context.Context, owner, repo, ref, lastSHA string) (string, error) {
+	sha, resp, err := c.GithubClient.Repositories.GetCommitSHA1(ctx, owner, repo, ref, lastSHA)
+	if resp != nil && resp.StatusCode == http.StatusNotModified {
+		return lastSHA, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return sha, nil
+}
+
+// ListTags lists all the tag names of the repository.
+func (c *Client) ListTags(ctx context.Context, owner, repo string) ([]string, error) {
+	var (
+		tags []string
+		opts = &github.ListOptions{PerPage: 100}
+	)
+	for {
+		page, resp, err := c.GithubClient.Repositories.ListTags(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range page {
+			tags = append(tags, tag.GetName())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return tags, nil
+}
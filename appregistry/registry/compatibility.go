@@ -0,0 +1,240 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+)
+
+// Well known dependency names that can be checked against the environment.
+const (
+	DependencyIgnite    = "ignite"
+	DependencyGo        = "go"
+	DependencyCosmosSDK = "cosmos-sdk"
+)
+
+const (
+	// CheckPassed means the environment satisfies the requirement.
+	CheckPassed CheckStatus = iota
+	// CheckFailed means the environment doesn't satisfy the requirement.
+	CheckFailed
+	// CheckSkipped means the requirement couldn't be checked.
+	CheckSkipped
+)
+
+type (
+	// Environment contains the versions of the local environment where an app is installed.
+	Environment struct {
+		// IgniteVersion is the version of the running Ignite CLI.
+		IgniteVersion string
+		// GoVersion is the version of the local Go toolchain.
+		GoVersion string
+		// CosmosSDKVersion is the Cosmos SDK version of the current chain, if any.
+		CosmosSDKVersion string
+	}
+
+	// CheckStatus represents the status of a compatibility check.
+	CheckStatus int
+
+	// CompatibilityCheck is the result of a single requirement check.
+	CompatibilityCheck struct {
+		Name        string
+		Requirement string
+		Version     string
+		Status      CheckStatus
+		Reason      string
+	}
+
+	// CompatibilityReport contains the result of all the requirement checks of an app.
+	CompatibilityReport []CompatibilityCheck
+)
+
+// String implements fmt.Stringer.
+func (s CheckStatus) String() string {
+	switch s {
+	case CheckPassed:
+		return "passed"
+	case CheckFailed:
+		return "failed"
+	default:
+		return "skipped"
+	}
+}
+
+// Compatible returns true if none of the checks failed.
+func (r CompatibilityReport) Compatible() bool {
+	for _, check := range r {
+		if check.Status == CheckFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer.
+func (r CompatibilityReport) String() string {
+	b := &strings.Builder{}
+	for _, check := range r {
+		icon := "✅"
+		switch check.Status {
+		case CheckFailed:
+			icon = "❌"
+		case CheckSkipped:
+			icon = "⚠️ "
+		}
+
+		fmt.Fprintf(b, "%s %s %s", icon, check.Name, check.Requirement)
+		if check.Version != "" {
+			fmt.Fprintf(b, " (found %s)", check.Version)
+		}
+		if check.Reason != "" {
+			fmt.Fprintf(b, ": %s", check.Reason)
+		}
+		fmt.Fprintln(b)
+	}
+	return b.String()
+}
+
+// CheckCompatibility checks the app requirements against the environment.
+func CheckCompatibility(app AppDetails, env Environment) CompatibilityReport {
+	var report CompatibilityReport
+
+	// the app must be built with the same major version of the Ignite CLI,
+	// otherwise the plugin protocol doesn't match.
+	if app.IgniteVersion != "" {
+		report = append(report, checkMajorVersion("ignite (go.mod)", app.IgniteVersion, env.IgniteVersion))
+	}
+	if app.IgniteConstraint != "" {
+		report = append(report, checkVersion("ignite", app.IgniteConstraint, env.IgniteVersion))
+	}
+	if app.GoVersion != "" {
+		report = append(report, checkVersion("go (go.mod)", Version(">="+app.GoVersion), env.GoVersion))
+	}
+	if app.CosmosSDKConstraint != "" {
+		report = append(report, checkVersion("cosmos-sdk", app.CosmosSDKConstraint, env.CosmosSDKVersion))
+	}
+
+	names := make([]string, 0, len(app.Dependencies))
+	for name := range app.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		constraint := app.Dependencies[name]
+		switch strings.ToLower(name) {
+		case DependencyIgnite:
+			report = append(report, checkVersion(name, constraint, env.IgniteVersion))
+		case DependencyGo:
+			report = append(report, checkVersion(name, constraint, env.GoVersion))
+		case DependencyCosmosSDK, "cosmossdk":
+			report = append(report, checkVersion(name, constraint, env.CosmosSDKVersion))
+		default:
+			report = append(report, CompatibilityCheck{
+				Name:        name,
+				Requirement: constraint.String(),
+				Status:      CheckSkipped,
+				Reason:      "unknown dependency, check it manually",
+			})
+		}
+	}
+
+	return report
+}
+
+func checkVersion(name string, constraint Version, version string) CompatibilityCheck {
+	check := CompatibilityCheck{
+		Name:        name,
+		Requirement: constraint.String(),
+		Version:     version,
+	}
+	if version == "" {
+		check.Status = CheckSkipped
+		check.Reason = "version not found in the current environment"
+		return check
+	}
+
+	if _, err := semver.NewVersion(normalizeVersion(version)); err != nil {
+		check.Status = CheckSkipped
+		check.Reason = fmt.Sprintf("unknown version format %s", version)
+		return check
+	}
+
+	if err := constraint.Verify(normalizeVersion(version)); err != nil {
+		check.Status = CheckFailed
+		check.Reason = err.Error()
+		return check
+	}
+
+	check.Status = CheckPassed
+	return check
+}
+
+func checkMajorVersion(name, appVersion, version string) CompatibilityCheck {
+	check := CompatibilityCheck{
+		Name:        name,
+		Requirement: appVersion,
+		Version:     version,
+	}
+	if version == "" {
+		check.Status = CheckSkipped
+		check.Reason = "version not found in the current environment"
+		return check
+	}
+
+	want, err := semver.NewVersion(normalizeVersion(appVersion))
+	if err != nil {
+		check.Status = CheckSkipped
+		check.Reason = err.Error()
+		return check
+	}
+	got, err := semver.NewVersion(normalizeVersion(version))
+	if err != nil {
+		check.Status = CheckSkipped
+		check.Reason = err.Error()
+		return check
+	}
+
+	if want.Major() != got.Major() {
+		check.Status = CheckFailed
+		check.Reason = fmt.Sprintf("app is built for Ignite v%d", want.Major())
+		return check
+	}
+
+	check.Status = CheckPassed
+	return check
+}
+
+// ChainCosmosSDKVersion returns the Cosmos SDK version used by the chain of the
+// directory, read from its go.mod file and the replace directives. An empty version
+// is returned if the directory has no go.mod file, doesn't depend on the Cosmos SDK
+// or replaces it by a local directory.
+func ChainCosmosSDKVersion(dir string) (string, error) {
+	goMod, err := parseLocalGoMod(dir)
+	if err != nil || goMod == nil {
+		return "", err
+	}
+
+	version, err := findRequireVersion(goMod, cosmosSDKPackage)
+	if err != nil {
+		return "", nil
+	}
+	for _, replace := range goMod.Replace {
+		if replace.Old.Path != cosmosSDKPackage || (replace.Old.Version != "" && replace.Old.Version != version) {
+			continue
+		}
+		// local replacements don't have a version.
+		return replace.New.Version, nil
+	}
+	return version, nil
+}
+
+// normalizeVersion removes the prefixes semver doesn't understand, e.g. the
+// "go" prefix of Go toolchain versions (go1.24.2).
+func normalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	version = strings.TrimPrefix(version, "go")
+	return version
+}
@@ -0,0 +1,165 @@
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	app := AppDetails{
+		GoVersion:           "1.24.0",
+		IgniteVersion:       "v29.2.0",
+		IgniteConstraint:    ">=29.0.0",
+		CosmosSDKConstraint: ">=0.53.0",
+		Dependencies: Dependencies{
+			"go":     ">=1.24",
+			"hermes": ">=1.13.0",
+		},
+	}
+
+	statuses := func(report CompatibilityReport) map[string]CheckStatus {
+		r := make(map[string]CheckStatus)
+		for _, check := range report {
+			r[check.Name] = check.Status
+		}
+		return r
+	}
+
+	tests := []struct {
+		name           string
+		env            Environment
+		want           map[string]CheckStatus
+		wantCompatible bool
+	}{
+		{
+			name: "compatible environment",
+			env: Environment{
+				IgniteVersion:    "v29.8.0",
+				GoVersion:        "go1.25.4",
+				CosmosSDKVersion: "v0.53.4",
+			},
+			want: map[string]CheckStatus{
+				"ignite (go.mod)": CheckPassed,
+				"ignite":          CheckPassed,
+				"go (go.mod)":     CheckPassed,
+				"cosmos-sdk":      CheckPassed,
+				"go":              CheckPassed,
+				"hermes":          CheckSkipped,
+			},
+			wantCompatible: true,
+		},
+		{
+			name: "outside of a chain",
+			env: Environment{
+				IgniteVersion: "v29.8.0",
+				GoVersion:     "go1.25.4",
+			},
+			want: map[string]CheckStatus{
+				"ignite (go.mod)": CheckPassed,
+				"ignite":          CheckPassed,
+				"go (go.mod)":     CheckPassed,
+				"cosmos-sdk":      CheckSkipped,
+				"go":              CheckPassed,
+				"hermes":          CheckSkipped,
+			},
+			wantCompatible: true,
+		},
+		{
+			name: "incompatible environment",
+			env: Environment{
+				IgniteVersion:    "v28.10.0",
+				GoVersion:        "go1.23.1",
+				CosmosSDKVersion: "v0.50.13",
+			},
+			want: map[string]CheckStatus{
+				"ignite (go.mod)": CheckFailed,
+				"ignite":          CheckFailed,
+				"go (go.mod)":     CheckFailed,
+				"cosmos-sdk":      CheckFailed,
+				"go":              CheckFailed,
+				"hermes":          CheckSkipped,
+			},
+			wantCompatible: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := CheckCompatibility(app, tt.env)
+			require.Equal(t, tt.want, statuses(report))
+			require.Equal(t, tt.wantCompatible, report.Compatible())
+		})
+	}
+}
+
+func TestChainCosmosSDKVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		goMod string
+		want  string
+	}{
+		{
+			name: "no go.mod",
+			want: "",
+		},
+		{
+			name: "required version",
+			goMod: `module github.com/acme/mars
+
+go 1.24
+
+require (
+	github.com/cosmos/cosmos-sdk v0.53.4
+	github.com/ignite/cli/v29 v29.2.0
+)
+`,
+			want: "v0.53.4",
+		},
+		{
+			name: "replaced version",
+			goMod: `module github.com/acme/mars
+
+go 1.24
+
+require github.com/cosmos/cosmos-sdk v0.53.4
+
+replace github.com/cosmos/cosmos-sdk => github.com/acme/cosmos-sdk v0.50.13-acme.1
+`,
+			want: "v0.50.13-acme.1",
+		},
+		{
+			name: "local replacement",
+			goMod: `module github.com/acme/mars
+
+go 1.24
+
+require github.com/cosmos/cosmos-sdk v0.53.4
+
+replace github.com/cosmos/cosmos-sdk => ../cosmos-sdk
+`,
+			want: "",
+		},
+		{
+			name: "not a chain",
+			goMod: `module github.com/acme/tool
+
+go 1.24
+`,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.goMod != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(tt.goMod), 0o644))
+			}
+
+			got, err := ChainCosmosSDKVersion(dir)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
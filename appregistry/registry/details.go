@@ -44,6 +44,13 @@ type AppDetails struct {
 	Path             string
 	GoVersion        string
 	IgniteVersion    string
+	Source           string
+
+	// IgniteConstraint, CosmosSDKConstraint and Dependencies are the
+	// requirements declared in the app registry entry.
+	IgniteConstraint    Version
+	CosmosSDKConstraint Version
+	Dependencies        Dependencies
 }
 
 // GetAppDetails returns the details of an Ignite app repository.
@@ -58,6 +65,10 @@ func (r Querier) GetAppDetails(ctx context.Context, appID, branch string) (*AppR
 		return nil, err
 	}
 
+	if r.offline {
+		return offlineAppDetails(appEntry), nil
+	}
+
 	repoOwner, repoName, err := validateRepoURL(appEntry.RepositoryURL.String())
 	if err != nil {
 		return nil, err
@@ -98,6 +109,11 @@ func (r Querier) GetAppDetails(ctx context.Context, appID, branch string) (*AppR
 			Path:             info.Path,
 			GoVersion:        goMod.Go.Version,
 			IgniteVersion:    cliVersion,
+			Source:           appEntry.Source,
+
+			IgniteConstraint:    appEntry.Ignite,
+			CosmosSDKConstraint: appEntry.CosmosSDK,
+			Dependencies:        appEntry.Dependencies,
 		}
 	}
 
@@ -114,6 +130,27 @@ func (r Querier) GetAppDetails(ctx context.Context, appID, branch string) (*AppR
 	return result, nil
 }
 
+// offlineAppDetails builds the app details only from the registry entry,
+// repository metadata like stars or go.mod versions are not available offline.
+func offlineAppDetails(appEntry App) *AppRepositoryDetails {
+	return &AppRepositoryDetails{
+		Name: appEntry.Name.String(),
+		URL:  appEntry.RepositoryURL.String(),
+		App: AppDetails{
+			Name:             appEntry.Name.String(),
+			AppID:            appEntry.AppID.String(),
+			PackageURL:       stripHTTPOrHTTPSFromURL(appEntry.RepositoryURL.String()),
+			DocumentationURL: appEntry.DocumentationURL.String(),
+			Description:      appEntry.Description.String(),
+			Source:           appEntry.Source,
+
+			IgniteConstraint:    appEntry.Ignite,
+			CosmosSDKConstraint: appEntry.CosmosSDK,
+			Dependencies:        appEntry.Dependencies,
+		},
+	}
+}
+
 func (r Querier) getGoMod(ctx context.Context, repo *github.Repository, fPath, branch string) (*modfile.File, error) {
 	contents, err := r.client.GetFileContent(
 		ctx,
@@ -174,10 +211,8 @@ func findCLIVersion(modFile *modfile.File) (string, error) {
 
 // stripHTTPOrHTTPSFromURL strips http or https scheme from a URL.
 func stripHTTPOrHTTPSFromURL(url string) string {
-	if url[:8] == "https://" {
-		url = url[8:]
-	} else if url[:7] == "http://" {
-		url = url[7:]
+	if strings.HasPrefix(url, "https://") {
+		return strings.TrimPrefix(url, "https://")
 	}
-	return url
+	return strings.TrimPrefix(url, "http://")
 }
@@ -0,0 +1,30 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineAppDetails(t *testing.T) {
+	details := offlineAppDetails(App{
+		Name:          "Explorer",
+		AppID:         "explorer",
+		RepositoryURL: "https://github.com/ignite/apps/explorer",
+	})
+	require.Equal(t, "https://github.com/ignite/apps/explorer", details.URL)
+	require.Equal(t, "github.com/ignite/apps/explorer", details.App.PackageURL)
+
+	// entries without repository url are listed without package url
+	details = offlineAppDetails(App{Name: "Notify", AppID: "notify"})
+	require.Empty(t, details.URL)
+	require.Empty(t, details.App.PackageURL)
+}
+
+func TestStripHTTPOrHTTPSFromURL(t *testing.T) {
+	require.Equal(t, "github.com/ignite/apps", stripHTTPOrHTTPSFromURL("https://github.com/ignite/apps"))
+	require.Equal(t, "github.com/ignite/apps", stripHTTPOrHTTPSFromURL("http://github.com/ignite/apps"))
+	require.Equal(t, "github.com/ignite/apps", stripHTTPOrHTTPSFromURL("github.com/ignite/apps"))
+	require.Equal(t, "http", stripHTTPOrHTTPSFromURL("http"))
+	require.Empty(t, stripHTTPOrHTTPSFromURL(""))
+}
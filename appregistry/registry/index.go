@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/config"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
+)
+
+const (
+	appRegistryDirectory = "appregistry"
+	indexDirectory       = "index"
+)
+
+// ErrIndexNotFound is returned when no local registry index exists for a branch.
+var ErrIndexNotFound = errors.New("local registry index not found, run `ignite appregistry sync` first")
+
+var indexFileNameReplacer = strings.NewReplacer("/", "_", ":", "_", "\\", "_")
+
+// Index represents the local cache of a registry source for a given branch.
+type Index struct {
+	Source    string    `json:"source"`
+	Branch    string    `json:"branch"`
+	Revision  string    `json:"revision"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Apps      Apps      `json:"apps"`
+}
+
+// DefaultIndexDir returns the default directory where the registry indexes are stored.
+func DefaultIndexDir() (string, error) {
+	return xfilepath.Join(
+		config.DirPath,
+		xfilepath.Path(appRegistryDirectory),
+		xfilepath.Path(indexDirectory),
+	)()
+}
+
+// indexFilePath returns the index file path for a source branch.
+func indexFilePath(dir, source, branch string) string {
+	// source names and branches can contain slashes or colons,
+	// e.g. github:acme/apps or feat/my-branch.
+	name := indexFileNameReplacer.Replace(source + "-" + branch)
+	return filepath.Join(dir, name+".json")
+}
+
+// LoadIndex loads the registry index of a source branch from the index directory.
+func LoadIndex(dir, source, branch string) (*Index, error) {
+	data, err := os.ReadFile(indexFilePath(dir, source, branch))
+	if os.IsNotExist(err) {
+		return nil, ErrIndexNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to read registry index")
+	}
+
+	var index Index
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, errors.Wrap(err, "failed to decode registry index")
+	}
+	return &index, nil
+}
+
+// Save writes the registry index into the index directory.
+func (i Index) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create registry index directory")
+	}
+
+	data, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode registry index")
+	}
+
+	// write into a temporary file first so a failed write never leaves a
+	// corrupted index behind.
+	path := indexFilePath(dir, i.Source, i.Branch)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return errors.Wrap(err, "failed to write registry index")
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,32 @@
+package registry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadIndex(dir, DefaultSourceName, "main")
+	require.ErrorIs(t, err, ErrIndexNotFound)
+
+	index := Index{
+		Source:    "github:acme/apps",
+		Branch:    "feat/registry",
+		Revision:  "0123456789abcdef",
+		UpdatedAt: time.Now().UTC().Truncate(time.Second),
+		Apps: Apps{
+			{Namespace: "ignite.apps.explorer", Name: "Explorer", AppID: "explorer"},
+		},
+	}
+	require.NoError(t, index.Save(dir))
+
+	got, err := LoadIndex(dir, "github:acme/apps", "feat/registry")
+	require.NoError(t, err)
+	require.Equal(t, index.Revision, got.Revision)
+	require.True(t, index.UpdatedAt.Equal(got.UpdatedAt))
+	require.Equal(t, index.Apps, got.Apps)
+}
@@ -2,8 +2,6 @@ package registry
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 	"regexp"
 	"strings"
 	"time"
@@ -22,70 +20,185 @@ const (
 
 var appFormatRegex = regexp.MustCompile(`^([a-z]+\.[a-z]+\.[a-z]+\.json)$`)
 
-type Querier struct {
-	client *xgithub.Client
+type (
+	// Querier queries the Ignite App Registry.
+	Querier struct {
+		client   *xgithub.Client
+		sources  []Source
+		offline  bool
+		indexDir string
+	}
+
+	// QuerierOption configures the registry Querier.
+	QuerierOption func(*Querier)
+)
+
+// WithOffline makes the querier read the registry only from the local index.
+func WithOffline() QuerierOption {
+	return func(q *Querier) {
+		q.offline = true
+	}
+}
+
+// WithIndexDir sets the directory where the local registry index is stored.
+func WithIndexDir(dir string) QuerierOption {
+	return func(q *Querier) {
+		q.indexDir = dir
+	}
 }
 
-func NewRegistryQuerier(client *xgithub.Client) *Querier {
-	return &Querier{client: client}
+// WithSources sets the registry sources sorted by priority, the entries of
+// the first sources take precedence over the entries with the same app id
+// from the next ones. By default only the Ignite App Registry is used.
+func WithSources(sources ...Source) QuerierOption {
+	return func(q *Querier) {
+		q.sources = sources
+	}
+}
+
+func NewRegistryQuerier(client *xgithub.Client, options ...QuerierOption) *Querier {
+	q := &Querier{client: client}
+	for _, apply := range options {
+		apply(q)
+	}
+	if len(q.sources) == 0 {
+		q.sources = []Source{DefaultSource(client)}
+	}
+	return q
 }
 
 // List list apps from the ignite app appregistry/registry.
+// Apps of all the sources are merged by priority order. The apps of each
+// source are read from the local registry index, which is refreshed only
+// when the source has a new revision. In offline mode the network is never
+// used.
 func (r *Querier) List(ctx context.Context, branch string) (Apps, error) {
-	appsFiles, err := r.client.GetDirectoryFiles(
-		ctx,
-		IgniteGitHubOrg,
-		IgniteAppsRepo,
-		registryDir,
-		xgithub.WithBranch(branch),
-	)
+	if branch == "" {
+		branch = "main"
+	}
+
+	indexDir, err := r.getIndexDir()
 	if err != nil {
 		return nil, err
 	}
 
-	entries := make(Apps, 0)
-	for _, file := range appsFiles {
-		if !appFormatRegex.MatchString(strings.TrimPrefix(file, registryDir+"/")) {
-			continue
+	var (
+		entries = make(Apps, 0)
+		seen    = make(map[string]struct{})
+	)
+	for _, source := range r.sources {
+		apps, err := r.listSource(ctx, source, indexDir, branch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list apps from registry source %s", source.Name())
 		}
 
-		entry, err := r.getRegistryEntry(ctx, file, branch)
-		if err != nil {
+		for _, app := range apps {
+			id := strings.ToLower(app.AppID.String())
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			app.Source = source.Name()
+			entries = append(entries, app)
+		}
+	}
+
+	return entries, nil
+}
+
+func (r *Querier) listSource(ctx context.Context, source Source, indexDir, branch string) (Apps, error) {
+	index, err := LoadIndex(indexDir, source.Name(), branch)
+	if err != nil && !errors.Is(err, ErrIndexNotFound) {
+		return nil, err
+	}
+
+	if r.offline {
+		if index == nil {
 			return nil, err
 		}
+		return index.Apps, nil
+	}
+
+	lastRevision := ""
+	if index != nil {
+		lastRevision = index.Revision
+	}
+
+	revision, err := source.Revision(ctx, branch, lastRevision)
+	if err != nil {
+		// the source can't be reached, fallback to the local index if any.
+		if index != nil {
+			return index.Apps, nil
+		}
+		return nil, err
+	}
 
-		entries = append(entries, *entry)
+	if index != nil && revision != "" && index.Revision == revision {
+		return index.Apps, nil
 	}
 
-	return entries, nil
+	index, err = r.sync(ctx, source, indexDir, branch, revision)
+	if err != nil {
+		return nil, err
+	}
+	return index.Apps, nil
 }
 
-func (r *Querier) getRegistryEntry(ctx context.Context, fileName, branch string) (*App, error) {
+// Sync fetches all registry entries of each source for a branch and
+// refreshes the local indexes.
+func (r *Querier) Sync(ctx context.Context, branch string) ([]*Index, error) {
+	if r.offline {
+		return nil, errors.New("can't sync the registry index in offline mode")
+	}
 	if branch == "" {
 		branch = "main"
 	}
-	// here we do not use `GetFileContent` to avoid hitting the github api rate limit
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodGet,
-		fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s/%s", IgniteGitHubOrg, IgniteAppsRepo, branch, fileName),
-		nil,
-	)
+
+	indexDir, err := r.getIndexDir()
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to build %s request", fileName)
+		return nil, err
 	}
-	resp, err := client.Do(req)
+
+	indexes := make([]*Index, 0, len(r.sources))
+	for _, source := range r.sources {
+		revision, err := source.Revision(ctx, branch, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve registry source %s revision", source.Name())
+		}
+
+		index, err := r.sync(ctx, source, indexDir, branch, revision)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to sync registry source %s", source.Name())
+		}
+		indexes = append(indexes, index)
+	}
+
+	return indexes, nil
+}
+
+func (r *Querier) sync(ctx context.Context, source Source, indexDir, branch, revision string) (*Index, error) {
+	apps, err := source.Apps(ctx, branch, revision)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get %s file content", fileName)
+		return nil, err
+	}
+
+	index := &Index{
+		Source:    source.Name(),
+		Branch:    branch,
+		Revision:  revision,
+		UpdatedAt: time.Now(),
+		Apps:      apps,
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("failed to get %s file content: %s", fileName, resp.Status)
+	if err := index.Save(indexDir); err != nil {
+		return nil, err
 	}
+	return index, nil
+}
 
-	namespace := namespaceFromFilePath(fileName)
-	return AppFromFile(namespace, resp.Body)
+func (r *Querier) getIndexDir() (string, error) {
+	if r.indexDir != "" {
+		return r.indexDir, nil
+	}
+	return DefaultIndexDir()
 }
@@ -0,0 +1,173 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// SeverityError is an issue making the app entry invalid.
+	SeverityError Severity = "error"
+	// SeverityWarning is an issue that should be fixed but doesn't invalidate the app entry.
+	SeverityWarning Severity = "warning"
+)
+
+// Validation rules.
+const (
+	RuleEntryFields   = "entry-fields"
+	RuleUniqueApp     = "unique-app"
+	RuleRepository    = "repository"
+	RuleAppConfig     = "app-config"
+	RuleGoMod         = "go-mod"
+	RuleIgniteVersion = "ignite-version"
+	RulePluginMain    = "plugin-main"
+	RuleImage         = "image"
+	RuleLicense       = "license"
+)
+
+const (
+	sarifSchema         = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion        = "2.1.0"
+	sarifToolName       = "appregistry"
+	sarifInformationURI = "https://github.com/ignite/apps/tree/main/appregistry"
+)
+
+type (
+	// Severity represents the severity of a validation issue.
+	Severity string
+
+	// Issue represents a validation issue of an app entry.
+	Issue struct {
+		Rule     string   `json:"rule"`
+		Severity Severity `json:"severity"`
+		Message  string   `json:"message"`
+	}
+
+	// ValidationReport contains all the validation issues of an app entry file.
+	ValidationReport struct {
+		File   string  `json:"file"`
+		Issues []Issue `json:"issues"`
+	}
+)
+
+// NewValidationReport creates a new empty validation report for an app entry file.
+func NewValidationReport(file string) *ValidationReport {
+	return &ValidationReport{File: file, Issues: make([]Issue, 0)}
+}
+
+// Errorf adds an error to the report.
+func (r *ValidationReport) Errorf(rule, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, Issue{Rule: rule, Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+// Warnf adds a warning to the report.
+func (r *ValidationReport) Warnf(rule, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, Issue{Rule: rule, Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// HasErrors returns true if the report contains at least one error.
+func (r ValidationReport) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements fmt.Stringer.
+func (r ValidationReport) String() string {
+	b := &strings.Builder{}
+	for _, issue := range r.Issues {
+		icon := "❌"
+		if issue.Severity == SeverityWarning {
+			icon = "⚠️ "
+		}
+		fmt.Fprintf(b, "%s [%s] %s\n", icon, issue.Rule, issue.Message)
+	}
+	return b.String()
+}
+
+// JSON returns the report encoded as JSON.
+func (r ValidationReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// SARIF returns the report encoded in the SARIF 2.1.0 format, used by code
+// scanning tools like GitHub code scanning.
+func (r ValidationReport) SARIF() ([]byte, error) {
+	type (
+		message struct {
+			Text string `json:"text"`
+		}
+		artifactLocation struct {
+			URI string `json:"uri"`
+		}
+		physicalLocation struct {
+			ArtifactLocation artifactLocation `json:"artifactLocation"`
+		}
+		location struct {
+			PhysicalLocation physicalLocation `json:"physicalLocation"`
+		}
+		result struct {
+			RuleID    string     `json:"ruleId"`
+			Level     Severity   `json:"level"`
+			Message   message    `json:"message"`
+			Locations []location `json:"locations"`
+		}
+		rule struct {
+			ID string `json:"id"`
+		}
+		driver struct {
+			Name           string `json:"name"`
+			InformationURI string `json:"informationUri"`
+			Rules          []rule `json:"rules"`
+		}
+		tool struct {
+			Driver driver `json:"driver"`
+		}
+		run struct {
+			Tool    tool     `json:"tool"`
+			Results []result `json:"results"`
+		}
+		log struct {
+			Schema  string `json:"$schema"`
+			Version string `json:"version"`
+			Runs    []run  `json:"runs"`
+		}
+	)
+
+	var (
+		rules   = make([]rule, 0)
+		results = make([]result, 0, len(r.Issues))
+		seen    = make(map[string]struct{})
+	)
+	for _, issue := range r.Issues {
+		if _, ok := seen[issue.Rule]; !ok {
+			seen[issue.Rule] = struct{}{}
+			rules = append(rules, rule{ID: issue.Rule})
+		}
+		results = append(results, result{
+			RuleID:  issue.Rule,
+			Level:   issue.Severity,
+			Message: message{Text: issue.Message},
+			Locations: []location{{
+				PhysicalLocation: physicalLocation{ArtifactLocation: artifactLocation{URI: r.File}},
+			}},
+		})
+	}
+
+	return json.MarshalIndent(log{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []run{{
+			Tool: tool{Driver: driver{
+				Name:           sarifToolName,
+				InformationURI: sarifInformationURI,
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}, "", "  ")
+}
@@ -0,0 +1,213 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/goccy/go-yaml"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/apps/appregistry/strcase"
+)
+
+const cosmosSDKPackage = "github.com/cosmos/cosmos-sdk"
+
+// DefaultSupportedPlatforms are the platforms supported by default by the Ignite apps.
+var DefaultSupportedPlatforms = []string{"mac", "linux"}
+
+// AppFileName returns the registry entry file name of an app, following the
+// <owner>.<repository>.<app id>.json naming rule.
+func AppFileName(owner, repo, appID string) string {
+	return strings.ToLower(fmt.Sprintf("%s.%s.%s.json", owner, repo, appID))
+}
+
+// ValidateAppFileName checks the file name follows the registry naming rule.
+// Registry entries with an invalid file name are not listed.
+func ValidateAppFileName(fileName string) error {
+	if !appFormatRegex.MatchString(fileName) {
+		return errors.Errorf("file name %s must match %s", fileName, appFormatRegex.String())
+	}
+	return nil
+}
+
+// PrefillApp returns an app entry prefilled from the go.mod and app.ignite.yml
+// files of a local repository and from the GitHub repository metadata. All the
+// sources are optional, missing information is left empty.
+func (r Querier) PrefillApp(ctx context.Context, dir, repoURL, appID string) (App, error) {
+	var app App
+
+	goMod, err := parseLocalGoMod(dir)
+	if err != nil {
+		return app, err
+	}
+	if goMod != nil {
+		if repoURL == "" && strings.HasPrefix(goMod.Module.Mod.Path, "github.com/") {
+			repoURL = "https://" + goMod.Module.Mod.Path
+		}
+		if v, err := findCLIVersion(goMod); err == nil {
+			app.Ignite = majorConstraint(v)
+		}
+		if v, err := findRequireVersion(goMod, cosmosSDKPackage); err == nil {
+			app.CosmosSDK = minorConstraint(v)
+		}
+	}
+
+	appsConfig, err := parseLocalAppsConfig(dir)
+	if err != nil {
+		return app, err
+	}
+	if appsConfig != nil {
+		ids := make([]string, 0, len(appsConfig.Apps))
+		for id := range appsConfig.Apps {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			// use the requested app or the first app declared.
+			if appID != "" && !strings.EqualFold(id, appID) {
+				continue
+			}
+			appID = id
+			app.Description = Field(appsConfig.Apps[id].Description)
+			break
+		}
+	}
+
+	if appID != "" {
+		app.AppID = Field(strcase.ToKebab(appID))
+		app.Name = Field(strcase.ToUpperCamel(appID))
+	}
+	app.SupportedPlatforms = DefaultSupportedPlatforms
+
+	if repoURL == "" {
+		return app, nil
+	}
+	app.RepositoryURL = URL(repoURL)
+
+	owner, name, err := validateRepoURL(repoURL)
+	if err != nil || r.offline || r.client == nil {
+		// not a GitHub repository, the metadata can't be fetched.
+		return app, nil
+	}
+
+	repo, err := r.client.GetRepository(ctx, owner, name)
+	if err != nil {
+		return app, errors.Wrapf(err, "failed to get repository %s/%s", owner, name)
+	}
+
+	if app.Description == "" {
+		app.Description = Field(repo.GetDescription())
+	}
+	if len(repo.Topics) > 0 {
+		app.Keywords = repo.Topics
+	}
+	if license := repo.GetLicense(); license != nil {
+		app.License = License{
+			Name: Field(license.GetSPDXID()),
+			URL:  URL(fmt.Sprintf("%s/blob/%s/LICENSE", repo.GetHTMLURL(), repo.GetDefaultBranch())),
+		}
+	}
+	app.DocumentationURL = URL(fmt.Sprintf("%s/blob/%s/README.md", repo.GetHTMLURL(), repo.GetDefaultBranch()))
+	if login := repo.GetOwner().GetLogin(); login != "" {
+		app.Authors = Authors{{Name: Field(login)}}
+	}
+
+	return app, nil
+}
+
+// WriteAppFile writes the app entry into the registry directory and returns the file path.
+func WriteAppFile(dir, fileName string, app App) (string, error) {
+	// the namespace and the source are derived from the file and the
+	// registry, they are not part of the entry.
+	app.Namespace = ""
+	app.Source = ""
+
+	data, err := json.MarshalIndent(app, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode app entry")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", errors.Wrapf(err, "failed to create %s", dir)
+	}
+
+	filePath := filepath.Join(dir, fileName)
+	if err := os.WriteFile(filePath, append(data, '\n'), 0o644); err != nil {
+		return "", errors.Wrapf(err, "failed to write %s", filePath)
+	}
+	return filePath, nil
+}
+
+// RepositoryOwnerAndName returns the owner and the name of a GitHub repository URL.
+func RepositoryOwnerAndName(repoURL string) (owner, name string, err error) {
+	return validateRepoURL(repoURL)
+}
+
+func parseLocalGoMod(dir string) (*modfile.File, error) {
+	p := filepath.Join(dir, "go.mod")
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", p)
+	}
+
+	f, err := modfile.Parse(p, data, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", p)
+	}
+	return f, nil
+}
+
+func parseLocalAppsConfig(dir string) (*plugin.AppsConfig, error) {
+	p := filepath.Join(dir, appYMLFileName)
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", p)
+	}
+
+	var conf plugin.AppsConfig
+	if err := yaml.Unmarshal(data, &conf); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s", p)
+	}
+	return &conf, nil
+}
+
+func findRequireVersion(modFile *modfile.File, pkg string) (string, error) {
+	for _, require := range modFile.Require {
+		if require.Mod.Path == pkg || strings.HasPrefix(require.Mod.Path, pkg+"/") {
+			return require.Mod.Version, nil
+		}
+	}
+	return "", errors.Errorf("couldn't find %s version in go.mod", path.Base(pkg))
+}
+
+// majorConstraint returns a constraint compatible with the major version, e.g. v29.8.0 => >=29.0.0.
+func majorConstraint(version string) Version {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return ""
+	}
+	return Version(fmt.Sprintf(">=%d.0.0", v.Major()))
+}
+
+// minorConstraint returns a constraint compatible with the minor version, e.g. v0.53.4 => >=0.53.0.
+func minorConstraint(version string) Version {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return ""
+	}
+	return Version(fmt.Sprintf(">=%d.%d.0", v.Major(), v.Minor()))
+}
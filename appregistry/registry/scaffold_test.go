@@ -0,0 +1,69 @@
+package registry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefillApp(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(`module github.com/acme/relayer
+
+go 1.24.0
+
+require (
+	github.com/cosmos/cosmos-sdk v0.53.4
+	github.com/ignite/cli/v29 v29.8.0
+)
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.ignite.yml"), []byte(`version: 1
+apps:
+  relayer:
+    description: Relay IBC packets between chains
+    path: ./relayer
+`), 0o644))
+
+	// the offline mode doesn't fetch the GitHub metadata.
+	querier := NewRegistryQuerier(nil, WithOffline())
+	app, err := querier.PrefillApp(context.Background(), dir, "", "")
+	require.NoError(t, err)
+
+	require.EqualValues(t, "Relayer", app.Name)
+	require.EqualValues(t, "relayer", app.AppID)
+	require.EqualValues(t, "Relay IBC packets between chains", app.Description)
+	require.EqualValues(t, ">=29.0.0", app.Ignite)
+	require.EqualValues(t, ">=0.53.0", app.CosmosSDK)
+	require.EqualValues(t, "https://github.com/acme/relayer", app.RepositoryURL)
+	require.Equal(t, DefaultSupportedPlatforms, app.SupportedPlatforms)
+}
+
+func TestAppFileName(t *testing.T) {
+	fileName := AppFileName("Acme", "relayer", "relayer")
+	require.Equal(t, "acme.relayer.relayer.json", fileName)
+	require.NoError(t, ValidateAppFileName(fileName))
+	require.Error(t, ValidateAppFileName("acme.relayer.json"))
+}
+
+func TestAppFileNameMixedCaseOwner(t *testing.T) {
+	app := App{
+		AppID:         "notify",
+		RepositoryURL: "https://github.com/QuenumGerald/notify",
+	}
+	owner, repo, err := RepositoryOwnerAndName(app.RepositoryURL.String())
+	require.NoError(t, err)
+
+	fileName := AppFileName(owner, repo, app.AppID.String())
+	require.Equal(t, "quenumgerald.notify.notify.json", fileName)
+	require.NoError(t, ValidateAppFileName(fileName))
+
+	app.Namespace = strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	require.NoError(t, app.validateNamespace())
+
+	app.Namespace = "acme.notify.notify"
+	require.Error(t, app.validateNamespace())
+}
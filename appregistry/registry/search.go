@@ -0,0 +1,157 @@
+package registry
+
+import (
+	"sort"
+	"strings"
+)
+
+// Search scores used to rank the apps matching a query term.
+const (
+	scoreExactName    = 10
+	scoreExactID      = 10
+	scorePartialName  = 5
+	scoreExactKeyword = 4
+	scoreKeyword      = 2
+	scoreDescription  = 1
+)
+
+type (
+	// SearchOptions contains the query and the facets used to search apps.
+	SearchOptions struct {
+		// Query is matched against the app name, id, description and keywords.
+		Query string
+		// Platform filters apps supporting the platform.
+		Platform string
+		// Ignite filters apps compatible with the Ignite version.
+		Ignite string
+		// CosmosSDK filters apps compatible with the Cosmos SDK version.
+		CosmosSDK string
+		// License filters apps by license name.
+		License string
+		// Author filters apps by author name.
+		Author string
+	}
+
+	// SearchResult represents an app matching a search with its score.
+	SearchResult struct {
+		Score int `json:"score"`
+		App   App `json:"app"`
+	}
+)
+
+// Search returns the apps matching the search options ranked by relevance.
+// Apps without a query match are excluded unless the query is empty.
+func (a Apps) Search(opts SearchOptions) []SearchResult {
+	terms := strings.Fields(strings.ToLower(opts.Query))
+
+	results := make([]SearchResult, 0)
+	for _, app := range a {
+		if !app.matchFacets(opts) {
+			continue
+		}
+
+		score := app.score(terms)
+		if len(terms) > 0 && score == 0 {
+			continue
+		}
+		results = append(results, SearchResult{Score: score, App: app})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return strings.ToLower(results[i].App.Name.String()) < strings.ToLower(results[j].App.Name.String())
+	})
+
+	return results
+}
+
+// score returns the relevance of the app for the query terms.
+func (a App) score(terms []string) int {
+	var (
+		name        = strings.ToLower(a.Name.String())
+		id          = strings.ToLower(a.AppID.String())
+		description = strings.ToLower(a.Description.String())
+		score       = 0
+	)
+	for _, term := range terms {
+		switch {
+		case name == term:
+			score += scoreExactName
+		case strings.Contains(name, term):
+			score += scorePartialName
+		}
+
+		if id == term {
+			score += scoreExactID
+		}
+
+		for _, keyword := range a.Keywords {
+			keyword = strings.ToLower(keyword)
+			switch {
+			case keyword == term:
+				score += scoreExactKeyword
+			case strings.Contains(keyword, term):
+				score += scoreKeyword
+			}
+		}
+
+		if strings.Contains(description, term) {
+			score += scoreDescription
+		}
+	}
+	return score
+}
+
+// matchFacets checks if the app matches all the search facets.
+func (a App) matchFacets(opts SearchOptions) bool {
+	if opts.Platform != "" && !containsFold(a.SupportedPlatforms, opts.Platform) {
+		return false
+	}
+
+	if opts.Ignite != "" && !a.Ignite.Compatible(opts.Ignite) {
+		return false
+	}
+
+	if opts.CosmosSDK != "" && !a.CosmosSDK.Compatible(opts.CosmosSDK) {
+		return false
+	}
+
+	if opts.License != "" && !strings.EqualFold(a.License.Name.String(), opts.License) {
+		return false
+	}
+
+	if opts.Author != "" {
+		found := false
+		for _, author := range a.Authors {
+			if strings.Contains(strings.ToLower(author.Name.String()), strings.ToLower(opts.Author)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Compatible checks if the version satisfies the version constraint.
+// An empty constraint is compatible with any version.
+func (v Version) Compatible(version string) bool {
+	if v == "" {
+		return true
+	}
+	return v.Verify(version) == nil
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
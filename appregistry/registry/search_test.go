@@ -0,0 +1,103 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppsSearch(t *testing.T) {
+	apps := Apps{
+		{
+			Name:               "Explorer",
+			AppID:              "explorer",
+			Description:        "Easy to use terminal chain explorer",
+			Ignite:             ">=28.0.0",
+			CosmosSDK:          ">=0.50.1",
+			Keywords:           []string{"explorer", "terminal"},
+			SupportedPlatforms: []string{"mac", "linux"},
+			License:            License{Name: "MIT"},
+			Authors:            Authors{{Name: "Ignite"}},
+		},
+		{
+			Name:               "Hermes",
+			AppID:              "hermes",
+			Description:        "Relayer app to connect chains with the terminal",
+			Ignite:             ">=29.0.0",
+			CosmosSDK:          ">=0.53.0",
+			Keywords:           []string{"relayer", "ibc"},
+			SupportedPlatforms: []string{"linux"},
+			License:            License{Name: "Apache-2.0"},
+			Authors:            Authors{{Name: "Ignite"}},
+		},
+		{
+			Name:               "Notify",
+			AppID:              "notify",
+			Description:        "Real-time notifications",
+			Keywords:           []string{"notify", "events"},
+			SupportedPlatforms: []string{"mac"},
+			License:            License{Name: "MIT"},
+			Authors:            Authors{{Name: "Gerald Quenum"}},
+		},
+	}
+
+	names := func(results []SearchResult) []string {
+		r := make([]string, 0, len(results))
+		for _, result := range results {
+			r = append(r, result.App.Name.String())
+		}
+		return r
+	}
+
+	tests := []struct {
+		name string
+		opts SearchOptions
+		want []string
+	}{
+		{
+			name: "empty query returns all apps sorted by name",
+			opts: SearchOptions{},
+			want: []string{"Explorer", "Hermes", "Notify"},
+		},
+		{
+			name: "rank name matches before description matches",
+			opts: SearchOptions{Query: "terminal"},
+			want: []string{"Explorer", "Hermes"},
+		},
+		{
+			name: "match keywords",
+			opts: SearchOptions{Query: "ibc"},
+			want: []string{"Hermes"},
+		},
+		{
+			name: "filter by platform",
+			opts: SearchOptions{Platform: "Linux"},
+			want: []string{"Explorer", "Hermes"},
+		},
+		{
+			name: "filter by ignite version",
+			opts: SearchOptions{Ignite: "v28.5.0"},
+			want: []string{"Explorer", "Notify"},
+		},
+		{
+			name: "filter by cosmos sdk version",
+			opts: SearchOptions{CosmosSDK: "0.53.4"},
+			want: []string{"Explorer", "Hermes", "Notify"},
+		},
+		{
+			name: "filter by license and author",
+			opts: SearchOptions{License: "mit", Author: "quenum"},
+			want: []string{"Notify"},
+		},
+		{
+			name: "no match",
+			opts: SearchOptions{Query: "wasm"},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, names(apps.Search(tt.opts)))
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package registry
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/ignite/cli/v29/ignite/config"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
+
+	"github.com/ignite/apps/appregistry/pkg/xgithub"
+)
+
+const (
+	// DefaultSourceName is the name of the official Ignite App Registry source.
+	DefaultSourceName = "ignite"
+
+	sourcesConfigFile = "sources.yml"
+)
+
+const (
+	// SourceTypeGitHub is a registry directory inside a GitHub repository.
+	SourceTypeGitHub SourceType = "github"
+	// SourceTypeLocal is a registry directory in the local file system.
+	SourceTypeLocal SourceType = "local"
+	// SourceTypeHTTP is a static registry index served over HTTP.
+	SourceTypeHTTP SourceType = "http"
+)
+
+type (
+	// Source is a source of app registry entries.
+	Source interface {
+		// Name returns the unique name of the source.
+		Name() string
+
+		// Revision returns the current revision of the source for a branch.
+		// The revision is used to know if the local index is outdated, if
+		// lastRevision is still current it can be returned without fetching
+		// the whole source. An empty revision means the source must always
+		// be fetched.
+		Revision(ctx context.Context, branch, lastRevision string) (string, error)
+
+		// Apps returns all the app entries of the source for a branch at the
+		// given revision.
+		Apps(ctx context.Context, branch, revision string) (Apps, error)
+	}
+
+	// SourceType represents the type of registry source.
+	SourceType string
+
+	// SourceConfig describes a registry source.
+	SourceConfig struct {
+		// Name is the unique name of the source.
+		Name string `yaml:"name"`
+		// Type is the source type: github, local or http.
+		Type SourceType `yaml:"type"`
+		// Repository is the GitHub repository (owner/repo) for github sources.
+		Repository string `yaml:"repository,omitempty"`
+		// Path is the registry directory inside the repository for github
+		// sources or the registry directory for local sources.
+		Path string `yaml:"path,omitempty"`
+		// URL is the static index URL for http sources.
+		URL string `yaml:"url,omitempty"`
+	}
+
+	// SourcesConfig contains the registry sources sorted by priority,
+	// entries from the first sources take precedence over the next ones.
+	SourcesConfig struct {
+		Sources []SourceConfig `yaml:"sources"`
+		// ExcludeDefault excludes the official Ignite App Registry source,
+		// otherwise it is added after the configured sources if not listed.
+		ExcludeDefault bool `yaml:"exclude_default,omitempty"`
+	}
+)
+
+// DefaultSourcesConfigPath returns the default path of the registry sources config file.
+func DefaultSourcesConfigPath() (string, error) {
+	return xfilepath.Join(
+		config.DirPath,
+		xfilepath.Path(appRegistryDirectory),
+		xfilepath.Path(sourcesConfigFile),
+	)()
+}
+
+// LoadSourcesConfig loads the registry sources config file.
+// If the file doesn't exist, an empty config is returned.
+func LoadSourcesConfig(path string) (SourcesConfig, error) {
+	var cfg SourcesConfig
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	} else if err != nil {
+		return cfg, errors.Wrapf(err, "failed to read registry sources config %s", path)
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, errors.Wrapf(err, "failed to decode registry sources config %s", path)
+	}
+	return cfg, nil
+}
+
+// ParseSourceConfig parses a source from its short form:
+//
+//	github:<owner>/<repo>[/<path>]
+//	local:<path>
+//	http(s)://<url>
+func ParseSourceConfig(s string) (SourceConfig, error) {
+	switch {
+	case strings.HasPrefix(s, "http://"), strings.HasPrefix(s, "https://"):
+		return SourceConfig{Name: s, Type: SourceTypeHTTP, URL: s}, nil
+	case strings.HasPrefix(s, "local:"):
+		dir := strings.TrimPrefix(s, "local:")
+		return SourceConfig{Name: s, Type: SourceTypeLocal, Path: dir}, nil
+	case strings.HasPrefix(s, "github:"):
+		parts := strings.SplitN(strings.TrimPrefix(s, "github:"), "/", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return SourceConfig{}, errors.Errorf("invalid github source %s, expected github:<owner>/<repo>[/<path>]", s)
+		}
+		cfg := SourceConfig{Name: s, Type: SourceTypeGitHub, Repository: parts[0] + "/" + parts[1]}
+		if len(parts) == 3 {
+			cfg.Path = parts[2]
+		}
+		return cfg, nil
+	default:
+		return SourceConfig{}, errors.Errorf("unknown registry source %s", s)
+	}
+}
+
+// Validate validates the source config.
+func (c SourceConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("source name is required")
+	}
+	switch c.Type {
+	case SourceTypeGitHub:
+		if len(strings.Split(c.Repository, "/")) != 2 {
+			return errors.Errorf("source %s: invalid repository %s, expected <owner>/<repo>", c.Name, c.Repository)
+		}
+	case SourceTypeLocal:
+		if c.Path == "" {
+			return errors.Errorf("source %s: path is required", c.Name)
+		}
+	case SourceTypeHTTP:
+		if c.URL == "" {
+			return errors.Errorf("source %s: url is required", c.Name)
+		}
+	default:
+		return errors.Errorf("source %s: unknown source type %s", c.Name, c.Type)
+	}
+	return nil
+}
+
+// NewSource creates the registry source described by the config.
+func NewSource(client *xgithub.Client, c SourceConfig) (Source, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch c.Type {
+	case SourceTypeGitHub:
+		owner, repo, _ := strings.Cut(c.Repository, "/")
+		dir := c.Path
+		if dir == "" {
+			dir = registryDir
+		}
+		return NewGitHubSource(c.Name, client, owner, repo, dir), nil
+	case SourceTypeLocal:
+		return NewLocalSource(c.Name, c.Path), nil
+	default:
+		return NewHTTPSource(c.Name, c.URL), nil
+	}
+}
+
+// NewSources creates the registry sources from the config, keeping the priority order.
+// The official Ignite App Registry is added as the lowest priority source, unless it is
+// already listed or excluded by the config.
+func NewSources(client *xgithub.Client, cfg SourcesConfig) ([]Source, error) {
+	var (
+		sources = make([]Source, 0, len(cfg.Sources))
+		names   = make(map[string]struct{})
+	)
+	for _, c := range cfg.Sources {
+		if _, ok := names[c.Name]; ok {
+			return nil, errors.Errorf("duplicate registry source name %s", c.Name)
+		}
+		names[c.Name] = struct{}{}
+
+		source, err := NewSource(client, c)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+
+	if !cfg.ExcludeDefault && !cfg.hasDefault() {
+		sources = append(sources, DefaultSource(client))
+	}
+	if len(sources) == 0 {
+		return nil, errors.New("no registry source configured")
+	}
+	return sources, nil
+}
+
+// hasDefault returns true if the official Ignite App Registry is one of the configured sources.
+func (cfg SourcesConfig) hasDefault() bool {
+	for _, c := range cfg.Sources {
+		if c.Name == DefaultSourceName {
+			return true
+		}
+		if c.Type == SourceTypeGitHub &&
+			strings.EqualFold(c.Repository, IgniteGitHubOrg+"/"+IgniteAppsRepo) &&
+			(c.Path == "" || strings.Trim(c.Path, "/") == registryDir) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultSource returns the official Ignite App Registry source.
+func DefaultSource(client *xgithub.Client) Source {
+	return NewGitHubSource(DefaultSourceName, client, IgniteGitHubOrg, IgniteAppsRepo, registryDir)
+}
@@ -0,0 +1,109 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+
+	"github.com/ignite/apps/appregistry/pkg/xgithub"
+)
+
+// GitHubSource is a registry source reading the app entries from a
+// directory of a GitHub repository.
+type GitHubSource struct {
+	name   string
+	client *xgithub.Client
+	owner  string
+	repo   string
+	dir    string
+}
+
+var _ Source = GitHubSource{}
+
+// NewGitHubSource creates a new GitHub registry source.
+func NewGitHubSource(name string, client *xgithub.Client, owner, repo, dir string) GitHubSource {
+	return GitHubSource{
+		name:   name,
+		client: client,
+		owner:  owner,
+		repo:   repo,
+		dir:    strings.Trim(dir, "/"),
+	}
+}
+
+// Name implements Source.
+func (s GitHubSource) Name() string {
+	return s.name
+}
+
+// Revision implements Source, the revision is the commit SHA of the branch.
+func (s GitHubSource) Revision(ctx context.Context, branch, lastRevision string) (string, error) {
+	return s.client.GetCommitSHA(ctx, s.owner, s.repo, branch, lastRevision)
+}
+
+// Apps implements Source.
+func (s GitHubSource) Apps(ctx context.Context, _, revision string) (Apps, error) {
+	appsFiles, err := s.client.GetDirectoryFiles(
+		ctx,
+		s.owner,
+		s.repo,
+		s.dir,
+		xgithub.WithBranch(revision),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(Apps, 0)
+	for _, file := range appsFiles {
+		if !appFormatRegex.MatchString(strings.TrimPrefix(file, s.dir+"/")) {
+			continue
+		}
+
+		// fetch the files from the resolved commit, so the index is consistent
+		// even if the branch moves while syncing.
+		entry, err := s.getRegistryEntry(ctx, file, revision)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, *entry)
+	}
+
+	return entries, nil
+}
+
+func (s GitHubSource) getRegistryEntry(ctx context.Context, fileName, ref string) (*App, error) {
+	if ref == "" {
+		ref = "main"
+	}
+	// here we do not use `GetFileContent` to avoid hitting the github api rate limit
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s/%s", s.owner, s.repo, ref, path.Clean(fileName)),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build %s request", fileName)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s file content", fileName)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get %s file content: %s", fileName, resp.Status)
+	}
+
+	namespace := namespaceFromFilePath(fileName)
+	return AppFromFile(namespace, resp.Body)
+}
@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// HTTPSource is a registry source reading the app entries from a static
+// JSON index served over HTTP. The index has the same format as the local
+// registry index ({"apps": [...]}) or is a plain JSON array of app entries.
+type HTTPSource struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+var _ Source = HTTPSource{}
+
+// NewHTTPSource creates a new static HTTP index registry source.
+func NewHTTPSource(name, url string) HTTPSource {
+	return HTTPSource{
+		name:   name,
+		url:    url,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Name implements Source.
+func (s HTTPSource) Name() string {
+	return s.name
+}
+
+// Revision implements Source, the revision is the ETag or the last
+// modification date of the index. If the server doesn't provide any,
+// the revision is empty and the index is always fetched.
+func (s HTTPSource) Revision(ctx context.Context, _, _ string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.url, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to build %s request", s.url)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to reach %s", s.url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to reach %s: %s", s.url, resp.Status)
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		return etag, nil
+	}
+	return resp.Header.Get("Last-Modified"), nil
+}
+
+// Apps implements Source.
+func (s HTTPSource) Apps(ctx context.Context, _, _ string) (Apps, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build %s request", s.url)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", s.url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get %s: %s", s.url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", s.url)
+	}
+
+	return decodeStaticIndex(body)
+}
+
+// decodeStaticIndex decodes a static index, either an index object or an array of apps.
+func decodeStaticIndex(data []byte) (Apps, error) {
+	var apps Apps
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &apps); err != nil {
+			return nil, errors.Wrap(err, "failed to decode static index")
+		}
+		return apps, nil
+	}
+
+	var index Index
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, errors.Wrap(err, "failed to decode static index")
+	}
+	return index.Apps, nil
+}
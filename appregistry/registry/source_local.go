@@ -0,0 +1,91 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// LocalSource is a registry source reading the app entries from a local directory.
+type LocalSource struct {
+	name string
+	dir  string
+}
+
+var _ Source = LocalSource{}
+
+// NewLocalSource creates a new local directory registry source.
+func NewLocalSource(name, dir string) LocalSource {
+	return LocalSource{name: name, dir: dir}
+}
+
+// Name implements Source.
+func (s LocalSource) Name() string {
+	return s.name
+}
+
+// Revision implements Source, the revision is a hash of all the app entry files.
+func (s LocalSource) Revision(context.Context, string, string) (string, error) {
+	files, err := s.files()
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read %s", file)
+		}
+		h.Write([]byte(filepath.Base(file)))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Apps implements Source.
+func (s LocalSource) Apps(context.Context, string, string) (Apps, error) {
+	files, err := s.files()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(Apps, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", file)
+		}
+
+		entry, err := AppFromFile(namespaceFromFilePath(file), bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid app entry %s", file)
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+// files returns the sorted app entry files of the directory.
+func (s LocalSource) files() ([]string, error) {
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read registry directory %s", s.dir)
+	}
+
+	files := make([]string, 0, len(dirEntries))
+	for _, entry := range dirEntries {
+		if entry.IsDir() || !appFormatRegex.MatchString(entry.Name()) {
+			continue
+		}
+		files = append(files, filepath.Join(s.dir, entry.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
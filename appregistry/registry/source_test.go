@@ -0,0 +1,182 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSourceConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		want    SourceConfig
+		wantErr bool
+	}{
+		{
+			name:   "github source",
+			source: "github:acme/apps",
+			want:   SourceConfig{Name: "github:acme/apps", Type: SourceTypeGitHub, Repository: "acme/apps"},
+		},
+		{
+			name:   "github source with path",
+			source: "github:acme/apps/registry/entries",
+			want:   SourceConfig{Name: "github:acme/apps/registry/entries", Type: SourceTypeGitHub, Repository: "acme/apps", Path: "registry/entries"},
+		},
+		{
+			name:   "local source",
+			source: "local:/tmp/registry",
+			want:   SourceConfig{Name: "local:/tmp/registry", Type: SourceTypeLocal, Path: "/tmp/registry"},
+		},
+		{
+			name:   "http source",
+			source: "https://registry.acme.com/index.json",
+			want:   SourceConfig{Name: "https://registry.acme.com/index.json", Type: SourceTypeHTTP, URL: "https://registry.acme.com/index.json"},
+		},
+		{
+			name:    "invalid github source",
+			source:  "github:acme",
+			wantErr: true,
+		},
+		{
+			name:    "unknown source",
+			source:  "ftp://registry.acme.com",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSourceConfig(tt.source)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewSources(t *testing.T) {
+	local := SourceConfig{Name: "local", Type: SourceTypeLocal, Path: "/tmp/registry"}
+	tests := []struct {
+		name    string
+		cfg     SourcesConfig
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "default source only",
+			want: []string{DefaultSourceName},
+		},
+		{
+			name: "default source added after the configured sources",
+			cfg:  SourcesConfig{Sources: []SourceConfig{local}},
+			want: []string{"local", DefaultSourceName},
+		},
+		{
+			name: "default source already listed",
+			cfg: SourcesConfig{Sources: []SourceConfig{
+				{Name: "github:ignite/apps", Type: SourceTypeGitHub, Repository: "ignite/apps"},
+				local,
+			}},
+			want: []string{"github:ignite/apps", "local"},
+		},
+		{
+			name: "default source excluded",
+			cfg:  SourcesConfig{Sources: []SourceConfig{local}, ExcludeDefault: true},
+			want: []string{"local"},
+		},
+		{
+			name:    "no sources",
+			cfg:     SourcesConfig{ExcludeDefault: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources, err := NewSources(nil, tt.cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(sources))
+			for _, source := range sources {
+				names = append(names, source.Name())
+			}
+			require.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestQuerierListSources(t *testing.T) {
+	ctx := context.Background()
+
+	writeApp := func(t *testing.T, dir, fileName string, app App) {
+		t.Helper()
+		data, err := json.Marshal(app)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fileName), data, 0o644))
+	}
+
+	internalDir := t.TempDir()
+	writeApp(t, internalDir, "acme.apps.explorer.json", App{Name: "Explorer", AppID: "explorer", Description: "internal explorer"})
+	writeApp(t, internalDir, "acme.apps.faucet.json", App{Name: "Faucet", AppID: "faucet"})
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		require.NoError(t, json.NewEncoder(w).Encode(Index{
+			Apps: Apps{
+				{Namespace: "ignite.apps.explorer", Name: "Explorer", AppID: "explorer", Description: "public explorer"},
+				{Namespace: "ignite.apps.hermes", Name: "Hermes", AppID: "hermes"},
+			},
+		}))
+	}))
+	defer ts.Close()
+
+	indexDir := t.TempDir()
+	querier := NewRegistryQuerier(
+		nil,
+		WithIndexDir(indexDir),
+		WithSources(
+			NewLocalSource("internal", internalDir),
+			NewHTTPSource("mirror", ts.URL),
+		),
+	)
+
+	apps, err := querier.List(ctx, "main")
+	require.NoError(t, err)
+	require.Len(t, apps, 3)
+
+	explorer, err := apps.FindByID("explorer")
+	require.NoError(t, err)
+	require.Equal(t, "internal", explorer.Source)
+	require.EqualValues(t, "internal explorer", explorer.Description)
+
+	hermes, err := apps.FindByID("hermes")
+	require.NoError(t, err)
+	require.Equal(t, "mirror", hermes.Source)
+
+	// the offline mode reads the indexes written by the previous list.
+	ts.Close()
+	offlineQuerier := NewRegistryQuerier(
+		nil,
+		WithOffline(),
+		WithIndexDir(indexDir),
+		WithSources(
+			NewLocalSource("internal", internalDir),
+			NewHTTPSource("mirror", ts.URL),
+		),
+	)
+	offlineApps, err := offlineQuerier.List(ctx, "main")
+	require.NoError(t, err)
+	require.Equal(t, apps, offlineApps)
+}
@@ -75,6 +75,7 @@ type (
 	// App represents an Ignite application with its metadata.
 	App struct {
 		Namespace          string       `json:"namespace,omitempty"`
+		Source             string       `json:"source,omitempty"`
 		Name               Field        `json:"appName"`
 		AppID              Field        `json:"appID"`
 		Description        Field        `json:"appDescription,omitempty"`
@@ -396,89 +397,150 @@ func (a Apps) CheckUnique() error {
 
 // Validate validates App fields.
 func (a App) Validate() error {
-	if err := a.Name.Validate(ValidateRequired(), ValidateFieldCase(CaseUpperCamel)); err != nil {
-		return errors.Wrapf(err, "invalid app name %s", a.Name)
-	}
-
-	if err := a.AppID.Validate(ValidateRequired(), ValidateFieldCase(CaseKebab)); err != nil {
-		return errors.Wrapf(err, "invalid app id %s", a.AppID)
-	}
-
-	if err := a.Description.Validate(ValidateRequired(), ValidationLength(10)); err != nil {
-		return errors.Wrapf(err, "invalid app description %s", a.Description)
-	}
-
-	if err := a.Ignite.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid ignite version %s", a.Ignite)
-	}
-
-	if err := a.Dependencies.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid dependencies %s", a.Dependencies)
-	}
-
-	if err := a.CosmosSDK.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid cosmos sdk version %s", a.CosmosSDK)
-	}
-
-	if err := a.Authors.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid authors %s", a.Authors)
-	}
-
-	if err := a.RepositoryURL.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid repository url %s", a.RepositoryURL)
-	}
-
-	if err := a.validateNamespace(); err != nil {
-		return errors.Wrapf(err, "invalid namespace %s", a.Namespace)
-	}
-
-	if err := a.DocumentationURL.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid documentation url %s", a.DocumentationURL)
-	}
-
-	if err := a.License.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid license %s", a.License)
-	}
-
-	if len(a.Keywords) == 0 {
-		return errors.Errorf("unless one keyword must be defined")
-	}
-
-	if len(a.SupportedPlatforms) == 0 {
-		return errors.Errorf("unless one supportedPlatforms must be defined")
-	}
-
-	if a.SocialMedia.Website != "" {
-		if err := a.SocialMedia.Website.Validate(); err != nil {
-			return errors.Wrapf(err, "invalid social media website %s", a.SocialMedia)
-		}
-	}
-
-	if a.Donations.CryptoAddresses.Cosmos != "" {
-		if err := a.Donations.CryptoAddresses.Cosmos.Validate(); err != nil {
-			return errors.Wrapf(err, "invalid cosmos crypto address %s", a.Donations.CryptoAddresses.Cosmos)
+	for _, rule := range a.validationRules() {
+		if err := rule.validate(); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	if len(a.Donations.FiatDonationLinks) > 0 {
-		if err := a.Donations.FiatDonationLinks.Validate(); err != nil {
-			return errors.Wrap(err, "invalid fiat donation link")
+// ValidateField validates a single App field by its JSON name,
+// e.g. appName or license.
+func (a App) ValidateField(name string) error {
+	for _, rule := range a.validationRules() {
+		if rule.field == name {
+			return rule.validate()
 		}
 	}
+	return errors.Errorf("unknown app field %s", name)
+}
 
-	if a.Icon != "" {
-		if err := a.Icon.Validate(); err != nil {
-			return errors.Wrapf(err, "invalid icon url %s", a.Icon)
-		}
-	}
+// appValidationRule is the validation rule of an App field.
+type appValidationRule struct {
+	field    string
+	validate func() error
+}
 
-	if a.Cover != "" {
-		if err := a.Cover.Validate(); err != nil {
-			return errors.Wrapf(err, "invalid cover url %s", a.Cover)
-		}
+// validationRules returns the validation rules of the App fields in validation order.
+func (a App) validationRules() []appValidationRule {
+	return []appValidationRule{
+		{field: "appName", validate: func() error {
+			if err := a.Name.Validate(ValidateRequired(), ValidateFieldCase(CaseUpperCamel)); err != nil {
+				return errors.Wrapf(err, "invalid app name %s", a.Name)
+			}
+			return nil
+		}},
+		{field: "appID", validate: func() error {
+			if err := a.AppID.Validate(ValidateRequired(), ValidateFieldCase(CaseKebab)); err != nil {
+				return errors.Wrapf(err, "invalid app id %s", a.AppID)
+			}
+			return nil
+		}},
+		{field: "appDescription", validate: func() error {
+			if err := a.Description.Validate(ValidateRequired(), ValidationLength(10)); err != nil {
+				return errors.Wrapf(err, "invalid app description %s", a.Description)
+			}
+			return nil
+		}},
+		{field: "ignite", validate: func() error {
+			if err := a.Ignite.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid ignite version %s", a.Ignite)
+			}
+			return nil
+		}},
+		{field: "dependencies", validate: func() error {
+			if err := a.Dependencies.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid dependencies %s", a.Dependencies)
+			}
+			return nil
+		}},
+		{field: "cosmosSDK", validate: func() error {
+			if err := a.CosmosSDK.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid cosmos sdk version %s", a.CosmosSDK)
+			}
+			return nil
+		}},
+		{field: "authors", validate: func() error {
+			if err := a.Authors.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid authors %s", a.Authors)
+			}
+			return nil
+		}},
+		{field: "repositoryUrl", validate: func() error {
+			if err := a.RepositoryURL.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid repository url %s", a.RepositoryURL)
+			}
+			return nil
+		}},
+		{field: "namespace", validate: func() error {
+			if err := a.validateNamespace(); err != nil {
+				return errors.Wrapf(err, "invalid namespace %s", a.Namespace)
+			}
+			return nil
+		}},
+		{field: "documentationUrl", validate: func() error {
+			if err := a.DocumentationURL.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid documentation url %s", a.DocumentationURL)
+			}
+			return nil
+		}},
+		{field: "license", validate: func() error {
+			if err := a.License.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid license %s", a.License)
+			}
+			return nil
+		}},
+		{field: "keywords", validate: func() error {
+			if len(a.Keywords) == 0 {
+				return errors.Errorf("unless one keyword must be defined")
+			}
+			return nil
+		}},
+		{field: "supportedPlatforms", validate: func() error {
+			if len(a.SupportedPlatforms) == 0 {
+				return errors.Errorf("unless one supportedPlatforms must be defined")
+			}
+			return nil
+		}},
+		{field: "socialMedia", validate: func() error {
+			if a.SocialMedia.Website != "" {
+				if err := a.SocialMedia.Website.Validate(); err != nil {
+					return errors.Wrapf(err, "invalid social media website %s", a.SocialMedia)
+				}
+			}
+			return nil
+		}},
+		{field: "donations", validate: func() error {
+			if a.Donations.CryptoAddresses.Cosmos != "" {
+				if err := a.Donations.CryptoAddresses.Cosmos.Validate(); err != nil {
+					return errors.Wrapf(err, "invalid cosmos crypto address %s", a.Donations.CryptoAddresses.Cosmos)
+				}
+			}
+			if len(a.Donations.FiatDonationLinks) > 0 {
+				if err := a.Donations.FiatDonationLinks.Validate(); err != nil {
+					return errors.Wrap(err, "invalid fiat donation link")
+				}
+			}
+			return nil
+		}},
+		{field: "icon", validate: func() error {
+			if a.Icon != "" {
+				if err := a.Icon.Validate(); err != nil {
+					return errors.Wrapf(err, "invalid icon url %s", a.Icon)
+				}
+			}
+			return nil
+		}},
+		{field: "cover", validate: func() error {
+			if a.Cover != "" {
+				if err := a.Cover.Validate(); err != nil {
+					return errors.Wrapf(err, "invalid cover url %s", a.Cover)
+				}
+			}
+			return nil
+		}},
 	}
-
-	return nil
 }
 
 // String returns the string representation of the Field.
@@ -492,10 +554,15 @@ func (u URL) String() string {
 }
 
 func (a App) validateNamespace() error {
-	namespaceURL := strings.ReplaceAll(a.Namespace, ".", "/")
-	extNamespaceURL := path.Dir(namespaceURL)
-	if !strings.HasSuffix(a.RepositoryURL.String(), namespaceURL) &&
-		!strings.HasSuffix(a.RepositoryURL.String(), extNamespaceURL) {
+	// the registry file names are lower case while GitHub owners and repositories
+	// aren't case sensitive, so the namespace is compared without case.
+	var (
+		repoURL         = strings.ToLower(a.RepositoryURL.String())
+		namespaceURL    = strings.ToLower(strings.ReplaceAll(a.Namespace, ".", "/"))
+		extNamespaceURL = path.Dir(namespaceURL)
+	)
+	if !strings.HasSuffix(repoURL, namespaceURL) &&
+		!strings.HasSuffix(repoURL, extNamespaceURL) {
 		return errors.Errorf("namespace (%s) name must with the repository URL (%s)", a.Namespace, a.RepositoryURL.String())
 	}
 	return nil
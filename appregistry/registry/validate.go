@@ -3,91 +3,298 @@ package registry
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
 	"os"
 	"path"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
-	"golang.org/x/mod/modfile"
-
+	"github.com/google/go-github/v56/github"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+
+	"github.com/ignite/apps/appregistry/pkg/xgithub"
 )
 
+const mainFileName = "main.go"
+
+var pluginPackagePattern = regexp.MustCompile(`^github\.com/ignite/cli(/v[0-9]+)?/ignite/services/plugin$`)
+
 // ValidateAppDetails validates the details of an Ignite app repository.
-func (r Querier) ValidateAppDetails(ctx context.Context, appFile, branch string) error {
+// All the issues found are returned in the report, the error is only
+// returned if the validation couldn't run.
+func (r Querier) ValidateAppDetails(ctx context.Context, appFile, branch string) (*ValidationReport, error) {
+	report := NewValidationReport(appFile)
+
 	appBytes, err := os.ReadFile(appFile)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get %s file content", appFile)
+		return nil, errors.Wrapf(err, "failed to get %s file content", appFile)
 	}
 
 	// load app entry from the file.
 	namespace := namespaceFromFilePath(appFile)
 	appEntry, err := AppFromFile(namespace, bytes.NewReader(appBytes))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// validate all JSON fields
 	if err := appEntry.Validate(); err != nil {
-		return err
+		report.Errorf(RuleEntryFields, "%s", err)
 	}
 
 	// check if the name and ID is unique
 	apps, err := r.List(ctx, branch)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := apps.CheckUnique(); err != nil {
-		return err
+		report.Errorf(RuleUniqueApp, "%s", err)
 	}
 
 	repoOwner, repoName, err := validateRepoURL(appEntry.RepositoryURL.String())
 	if err != nil {
-		return err
+		report.Errorf(RuleRepository, "%s", err)
+		return report, nil
 	}
 
 	repo, err := r.client.GetRepository(ctx, repoOwner, repoName)
 	if err != nil {
-		return err
+		report.Errorf(RuleRepository, "failed to get repository %s/%s: %s", repoOwner, repoName, err)
+		return report, nil
 	}
 
-	// get the go.mod file from the app repository
-	var goMod *modfile.File
-	if repoOwner == "ignite" && repoName == "apps" {
-		appYML, err := r.getAppsConfig(ctx, repo, branch)
-		if err != nil {
-			return err
-		}
+	// official apps use the registry branch, third party apps their default branch.
+	repoBranch := repo.GetDefaultBranch()
+	if repoOwner == IgniteGitHubOrg && repoName == IgniteAppsRepo {
+		repoBranch = branch
+	}
 
-		for id, info := range appYML.Apps {
-			if !strings.EqualFold(id, appEntry.AppID.String()) {
-				continue
-			}
+	appPath, ok := r.validateAppConfig(ctx, report, repo, appEntry, repoBranch)
+	if !ok {
+		return report, nil
+	}
 
-			goMod, err = r.getGoMod(ctx, repo, path.Clean(info.Path), branch)
-			if err != nil {
-				return errors.Wrapf(err, "failed to get go.mod for app %s", id)
-			}
+	r.validateGoMod(ctx, report, repo, appEntry, appPath, repoBranch)
+	r.validatePluginMain(ctx, report, repo, appPath, repoBranch)
+	validateLicense(report, repo, appEntry)
+	validateImage(ctx, report, "icon", resolveImageURL(appEntry.Icon, branch))
+	validateImage(ctx, report, "cover", resolveImageURL(appEntry.Cover, branch))
+
+	return report, nil
+}
+
+// validateAppConfig checks the app is registered in the app.ignite.yml file
+// of the repository and returns the app path.
+func (r Querier) validateAppConfig(
+	ctx context.Context,
+	report *ValidationReport,
+	repo *github.Repository,
+	appEntry *App,
+	branch string,
+) (string, bool) {
+	appYML, err := r.getAppsConfig(ctx, repo, branch)
+	if err != nil {
+		report.Errorf(RuleAppConfig, "app should be registered into the %s file: %s", appYMLFileName, err)
+		return "", false
+	}
+
+	var (
+		appPath string
+		found   bool
+	)
+	for id, info := range appYML.Apps {
+		if strings.EqualFold(id, appEntry.AppID.String()) {
+			appPath, found = path.Clean(info.Path), true
 			break
 		}
-		if goMod == nil {
-			return errors.Errorf("oficial ignite app should be register into the %s file", appYMLFileName)
-		}
-	} else {
-		goMod, err = r.getGoMod(ctx, repo, "", "main")
-		if err != nil {
-			return errors.Wrapf(err, "failed to get go.mod for app %s", appEntry.AppID)
-		}
+	}
+	if !found {
+		report.Errorf(RuleAppConfig, "app %s should be registered into the %s file", appEntry.AppID, appYMLFileName)
+		return "", false
+	}
+	return appPath, true
+}
+
+// validateGoMod checks the app go.mod exists and satisfies the ignite version constraint.
+func (r Querier) validateGoMod(
+	ctx context.Context,
+	report *ValidationReport,
+	repo *github.Repository,
+	appEntry *App,
+	appPath, branch string,
+) {
+	goMod, err := r.getGoMod(ctx, repo, appPath, branch)
+	if err != nil {
+		report.Errorf(RuleGoMod, "failed to get go.mod for app %s: %s", appEntry.AppID, err)
+		return
 	}
 
 	cliVersion, err := findCLIVersion(goMod)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find ignite version in go.mod for app %s", appEntry.AppID)
+		report.Errorf(RuleGoMod, "failed to find ignite version in go.mod for app %s: %s", appEntry.AppID, err)
+		return
 	}
 
 	if err := appEntry.Ignite.Verify(cliVersion); err != nil {
-		return errors.Wrapf(err, "wrong ignite version %s for app %s: %s", cliVersion, appEntry.AppID, appEntry.Ignite.String())
+		report.Errorf(RuleIgniteVersion, "wrong ignite version %s for app %s: %s", cliVersion, appEntry.AppID, appEntry.Ignite.String())
+	}
+}
+
+// validatePluginMain checks the app path contains a main.go file serving the Ignite plugin interface.
+func (r Querier) validatePluginMain(
+	ctx context.Context,
+	report *ValidationReport,
+	repo *github.Repository,
+	appPath, branch string,
+) {
+	mainPath := path.Join(appPath, mainFileName)
+	data, err := r.client.GetFileContent(
+		ctx,
+		repo.GetOwner().GetLogin(),
+		repo.GetName(),
+		mainPath,
+		xgithub.WithBranch(branch),
+	)
+	if err != nil {
+		report.Errorf(RulePluginMain, "failed to get %s: %s", mainPath, err)
+		return
+	}
+
+	if err := checkPluginMain(mainPath, data); err != nil {
+		report.Errorf(RulePluginMain, "%s", err)
+	}
+}
+
+// checkPluginMain checks the main file imports the Ignite plugin package and serves the app.
+func checkPluginMain(fileName string, data []byte) error {
+	f, err := parser.ParseFile(token.NewFileSet(), fileName, data, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %s", fileName)
+	}
+	if f.Name.Name != "main" {
+		return errors.Errorf("%s must be in the main package", fileName)
 	}
 
+	pluginImport := ""
+	for _, imp := range f.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if !pluginPackagePattern.MatchString(importPath) {
+			continue
+		}
+		pluginImport = path.Base(importPath)
+		if imp.Name != nil {
+			pluginImport = imp.Name.Name
+		}
+		break
+	}
+	if pluginImport == "" {
+		return errors.Errorf("%s must import the ignite plugin package", fileName)
+	}
+
+	if !callsPluginFunc(f, pluginImport, "NewGRPC") {
+		return errors.Errorf("%s must serve the app with %s.NewGRPC", fileName, pluginImport)
+	}
 	return nil
 }
+
+// callsPluginFunc returns true if the file calls the function of the imported package,
+// comments and strings mentioning the function are ignored.
+func callsPluginFunc(f *ast.File, pkgName, funcName string) bool {
+	found := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found {
+			return !found
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			pkg, ok := fun.X.(*ast.Ident)
+			found = ok && pkg.Name == pkgName && fun.Sel.Name == funcName
+		case *ast.Ident:
+			// dot imports call the function without the package name.
+			found = pkgName == "." && fun.Name == funcName
+		}
+		return !found
+	})
+	return found
+}
+
+// validateLicense checks the license detected by GitHub matches the app license.
+func validateLicense(report *ValidationReport, repo *github.Repository, appEntry *App) {
+	license := repo.GetLicense()
+	if license == nil {
+		report.Warnf(RuleLicense, "no license file detected in repository %s", repo.GetFullName())
+		return
+	}
+
+	name := appEntry.License.Name.String()
+	if !strings.EqualFold(name, license.GetSPDXID()) &&
+		!strings.EqualFold(name, license.GetKey()) &&
+		!strings.EqualFold(name, license.GetName()) {
+		report.Errorf(
+			RuleLicense,
+			"license %s doesn't match the repository license %s (%s)",
+			name,
+			license.GetSPDXID(),
+			license.GetName(),
+		)
+	}
+}
+
+// resolveImageURL resolves the image URLs relative to the app registry repository.
+func resolveImageURL(u URL, branch string) string {
+	s := u.String()
+	if s == "" || strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://") {
+		return s
+	}
+	return fmt.Sprintf(
+		"https://raw.githubusercontent.com/%s/%s/%s/%s",
+		IgniteGitHubOrg,
+		IgniteAppsRepo,
+		branch,
+		strings.TrimPrefix(path.Clean(s), "/"),
+	)
+}
+
+// validateImage checks the image URL resolves to an image.
+func validateImage(ctx context.Context, report *ValidationReport, name, imageURL string) {
+	if imageURL == "" {
+		return
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		report.Errorf(RuleImage, "invalid %s url %s: %s", name, imageURL, err)
+		return
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		report.Errorf(RuleImage, "unable to reach %s url %s: %s", name, imageURL, err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		report.Errorf(RuleImage, "%s url %s returned status code %d", name, imageURL, resp.StatusCode)
+		return
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	switch {
+	case contentType == "":
+		report.Warnf(RuleImage, "%s url %s has no content type", name, imageURL)
+	case !strings.HasPrefix(contentType, "image/"):
+		report.Errorf(RuleImage, "%s url %s is not an image (%s)", name, imageURL, contentType)
+	}
+}
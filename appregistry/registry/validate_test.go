@@ -0,0 +1,147 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPluginMain(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+	}{
+		{
+			name: "valid app",
+			file: `package main
+
+import (
+	hplugin "github.com/hashicorp/go-plugin"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+)
+
+func main() {
+	hplugin.Serve(&hplugin.ServeConfig{
+		Plugins: map[string]hplugin.Plugin{"app": plugin.NewGRPC(&app{})},
+	})
+}
+`,
+		},
+		{
+			name: "valid app with named import",
+			file: `package main
+
+import igniteplugin "github.com/ignite/cli/v28/ignite/services/plugin"
+
+func main() {
+	_ = igniteplugin.NewGRPC(&app{})
+}
+`,
+		},
+		{
+			name: "not a main package",
+			file: `package app
+
+import "github.com/ignite/cli/v29/ignite/services/plugin"
+
+var _ = plugin.NewGRPC(nil)
+`,
+			wantErr: "must be in the main package",
+		},
+		{
+			name: "missing plugin import",
+			file: `package main
+
+func main() {}
+`,
+			wantErr: "must import the ignite plugin package",
+		},
+		{
+			name: "app not served",
+			file: `package main
+
+import "github.com/ignite/cli/v29/ignite/services/plugin"
+
+var _ plugin.Interface = nil
+
+func main() {}
+`,
+			wantErr: "must serve the app with plugin.NewGRPC",
+		},
+		{
+			name: "app served only in a comment and a string",
+			file: `package main
+
+import "github.com/ignite/cli/v29/ignite/services/plugin"
+
+var _ plugin.Interface = nil
+
+// serve with plugin.NewGRPC(&app{})
+func main() {
+	println("plugin.NewGRPC(&app{})")
+}
+`,
+			wantErr: "must serve the app with plugin.NewGRPC",
+		},
+		{
+			name: "app served with another package function",
+			file: `package main
+
+import "github.com/ignite/cli/v29/ignite/services/plugin"
+
+var _ plugin.Interface = nil
+
+func main() {
+	_ = other.NewGRPC(&app{})
+}
+`,
+			wantErr: "must serve the app with plugin.NewGRPC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPluginMain("main.go", []byte(tt.file))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidationReportSARIF(t *testing.T) {
+	report := NewValidationReport("_registry/ignite.apps.explorer.json")
+	report.Errorf(RuleLicense, "license mismatch")
+	report.Warnf(RuleImage, "no content type")
+	require.True(t, report.HasErrors())
+
+	data, err := report.SARIF()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": [{
+			"tool": {"driver": {
+				"name": "appregistry",
+				"informationUri": "https://github.com/ignite/apps/tree/main/appregistry",
+				"rules": [{"id": "license"}, {"id": "image"}]
+			}},
+			"results": [
+				{
+					"ruleId": "license",
+					"level": "error",
+					"message": {"text": "license mismatch"},
+					"locations": [{"physicalLocation": {"artifactLocation": {"uri": "_registry/ignite.apps.explorer.json"}}}]
+				},
+				{
+					"ruleId": "image",
+					"level": "warning",
+					"message": {"text": "no content type"},
+					"locations": [{"physicalLocation": {"artifactLocation": {"uri": "_registry/ignite.apps.explorer.json"}}}]
+				}
+			]
+		}]
+	}`, string(data))
+}
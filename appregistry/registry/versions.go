@@ -0,0 +1,202 @@
+package registry
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// LatestVersion is the version alias resolving the newest released app version.
+const LatestVersion = "latest"
+
+type (
+	// AppVersion represents a released version of an app.
+	AppVersion struct {
+		Version *semver.Version
+		Tag     string
+	}
+
+	// InstalledApp represents a registry app installed in the Ignite apps config.
+	InstalledApp struct {
+		// Path is the app path from the Ignite apps config, e.g. github.com/ignite/apps/explorer@explorer/v1.0.0.
+		Path string
+		// PackageURL is the app path without the git reference.
+		PackageURL string
+		// Ref is the installed git reference, empty if the app follows the default branch.
+		Ref string
+		// App is the registry entry of the installed app.
+		App App
+	}
+)
+
+// SplitAppRef splits an app reference (<app>@<version>) into the app and the version.
+func SplitAppRef(s string) (app, version string) {
+	app, version, _ = strings.Cut(s, "@")
+	return app, version
+}
+
+// JoinAppRef joins a package URL with a git reference.
+func JoinAppRef(packageURL, ref string) string {
+	if ref == "" {
+		return packageURL
+	}
+	return packageURL + "@" + ref
+}
+
+// ListAppVersions returns the released versions of an app sorted from the newest.
+// Apps living in a sub directory of a repository (e.g. the explorer directory of
+// github.com/ignite/apps) are versioned with tags prefixed by the app path (e.g.
+// explorer/v1.0.0). Plain semver tags are only used for the apps at the repository
+// root, or if the repository doesn't have any prefixed tag.
+func (r Querier) ListAppVersions(ctx context.Context, app AppDetails) ([]AppVersion, error) {
+	if r.offline {
+		return nil, errors.New("can't list app versions in offline mode")
+	}
+
+	owner, repo, err := validateRepoURL(app.PackageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := r.client.ListTags(ctx, owner, repo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list tags of %s/%s", owner, repo)
+	}
+	return appVersionTags(tags, app.Path), nil
+}
+
+// AppDetailsAtVersion returns the app details with the Go and Ignite versions
+// read from the go.mod of the app at the version tag.
+func (r Querier) AppDetailsAtVersion(ctx context.Context, app AppDetails, version AppVersion) (AppDetails, error) {
+	owner, repoName, err := validateRepoURL(app.PackageURL)
+	if err != nil {
+		return AppDetails{}, err
+	}
+
+	repo, err := r.client.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return AppDetails{}, err
+	}
+
+	goMod, err := r.getGoMod(ctx, repo, path.Clean(app.Path), version.Tag)
+	if err != nil {
+		return AppDetails{}, errors.Wrapf(err, "failed to get go.mod for app %s at %s", app.AppID, version.Tag)
+	}
+
+	cliVersion, err := findCLIVersion(goMod)
+	if err != nil {
+		return AppDetails{}, errors.Wrapf(err, "failed to find ignite version in go.mod for app %s at %s", app.AppID, version.Tag)
+	}
+
+	app.GoVersion = goMod.Go.Version
+	app.IgniteVersion = cliVersion
+	return app, nil
+}
+
+// ResolveAppVersion returns the app version matching the requested version.
+// The version can be a semantic version (with or without the "v" prefix) or "latest".
+func ResolveAppVersion(versions []AppVersion, version string) (AppVersion, error) {
+	if len(versions) == 0 {
+		return AppVersion{}, errors.New("no released version found")
+	}
+	if version == "" || version == LatestVersion {
+		return versions[0], nil
+	}
+
+	want, err := semver.NewVersion(version)
+	if err != nil {
+		return AppVersion{}, errors.Wrapf(err, "invalid version %s", version)
+	}
+	for _, v := range versions {
+		if v.Version.Equal(want) {
+			return v, nil
+		}
+	}
+	return AppVersion{}, errors.Errorf("version %s not found", version)
+}
+
+// MatchInstalled returns the registry apps matching the installed app paths.
+func (a Apps) MatchInstalled(paths []string) []InstalledApp {
+	installed := make([]InstalledApp, 0)
+	for _, p := range paths {
+		packageURL, ref := SplitAppRef(p)
+		for _, app := range a {
+			repoURL := stripHTTPOrHTTPSFromURL(app.RepositoryURL.String())
+			if repoURL == "" {
+				continue
+			}
+			if packageURL != repoURL && !strings.HasPrefix(packageURL, repoURL+"/") {
+				continue
+			}
+			installed = append(installed, InstalledApp{
+				Path:       p,
+				PackageURL: packageURL,
+				Ref:        ref,
+				App:        app,
+			})
+			break
+		}
+	}
+	return installed
+}
+
+// InstalledVersion returns the installed version of the app, nil if the app
+// is not pinned to a released version.
+func (i InstalledApp) InstalledVersion(versions []AppVersion) *semver.Version {
+	for _, v := range versions {
+		if v.Tag == i.Ref {
+			return v.Version
+		}
+	}
+	return nil
+}
+
+// appVersionTags returns the version tags of the app at the path of the repository.
+func appVersionTags(tags []string, appPath string) []AppVersion {
+	subPath := strings.Trim(path.Clean("/"+appPath), "/")
+	if subPath == "" {
+		return parseVersionTags(tags, "")
+	}
+	if versions := parseVersionTags(tags, subPath); len(versions) > 0 {
+		return versions
+	}
+
+	// the tags of other apps of the repository aren't versions of this app.
+	for _, tag := range tags {
+		if i := strings.LastIndex(tag, "/"); i > 0 {
+			if _, err := semver.NewVersion(tag[i+1:]); err == nil {
+				return nil
+			}
+		}
+	}
+	return parseVersionTags(tags, "")
+}
+
+// parseVersionTags parses the semver tags with the given path prefix sorted from the newest.
+func parseVersionTags(tags []string, subPath string) []AppVersion {
+	prefix := ""
+	if subPath != "" {
+		prefix = subPath + "/"
+	}
+
+	versions := make([]AppVersion, 0)
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, prefix) {
+			continue
+		}
+		v, err := semver.NewVersion(strings.TrimPrefix(tag, prefix))
+		if err != nil {
+			continue
+		}
+		versions = append(versions, AppVersion{Version: v, Tag: tag})
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Version.GreaterThan(versions[j].Version)
+	})
+	return versions
+}
@@ -0,0 +1,121 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersionTags(t *testing.T) {
+	tags := []string{
+		"explorer/v0.2.0",
+		"explorer/v0.10.1",
+		"explorer/v0.1.0",
+		"hermes/v1.0.0",
+		"v2.0.0",
+		"not-a-version",
+	}
+
+	versions := parseVersionTags(tags, "explorer")
+	require.Len(t, versions, 3)
+	require.Equal(t, "explorer/v0.10.1", versions[0].Tag)
+	require.Equal(t, "explorer/v0.1.0", versions[2].Tag)
+
+	versions = parseVersionTags(tags, "")
+	require.Len(t, versions, 1)
+	require.Equal(t, "v2.0.0", versions[0].Tag)
+}
+
+func TestAppVersionTags(t *testing.T) {
+	monorepoTags := []string{
+		"spaceship/v0.3.0",
+		"spaceship/v0.2.1",
+		"explorer/v0.2.0",
+		"v29.0.0",
+	}
+
+	tests := []struct {
+		name    string
+		tags    []string
+		appPath string
+		want    []string
+	}{
+		{
+			name:    "app path prefixed tags",
+			tags:    monorepoTags,
+			appPath: "./spaceship",
+			want:    []string{"spaceship/v0.3.0", "spaceship/v0.2.1"},
+		},
+		{
+			name:    "monorepo app without release",
+			tags:    monorepoTags,
+			appPath: "hermes",
+			want:    []string{},
+		},
+		{
+			name:    "app at the repository root",
+			tags:    monorepoTags,
+			appPath: ".",
+			want:    []string{"v29.0.0"},
+		},
+		{
+			name:    "app in a sub directory of a repository with plain tags",
+			tags:    []string{"v1.0.0", "v1.1.0"},
+			appPath: "notify",
+			want:    []string{"v1.1.0", "v1.0.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions := appVersionTags(tt.tags, tt.appPath)
+			got := make([]string, 0, len(versions))
+			for _, v := range versions {
+				got = append(got, v.Tag)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveAppVersion(t *testing.T) {
+	versions := parseVersionTags([]string{"explorer/v0.1.0", "explorer/v0.2.0"}, "explorer")
+
+	got, err := ResolveAppVersion(versions, LatestVersion)
+	require.NoError(t, err)
+	require.Equal(t, "explorer/v0.2.0", got.Tag)
+
+	got, err = ResolveAppVersion(versions, "0.1.0")
+	require.NoError(t, err)
+	require.Equal(t, "explorer/v0.1.0", got.Tag)
+
+	_, err = ResolveAppVersion(versions, "v1.0.0")
+	require.Error(t, err)
+
+	_, err = ResolveAppVersion(nil, LatestVersion)
+	require.Error(t, err)
+}
+
+func TestAppsMatchInstalled(t *testing.T) {
+	apps := Apps{
+		// entries without a valid repository url are never matched
+		{AppID: "empty"},
+		{AppID: "short", RepositoryURL: "git"},
+		{AppID: "explorer", RepositoryURL: "https://github.com/ignite/apps/explorer"},
+		{AppID: "notify", RepositoryURL: "https://github.com/QuenumGerald/notify"},
+	}
+
+	installed := apps.MatchInstalled([]string{
+		"github.com/ignite/apps/explorer@explorer/v0.2.0",
+		"github.com/QuenumGerald/notify/notify",
+		"github.com/acme/unknown",
+	})
+	require.Len(t, installed, 2)
+
+	require.EqualValues(t, "explorer", installed[0].App.AppID)
+	require.Equal(t, "github.com/ignite/apps/explorer", installed[0].PackageURL)
+	require.Equal(t, "explorer/v0.2.0", installed[0].Ref)
+
+	require.EqualValues(t, "notify", installed[1].App.AppID)
+	require.Equal(t, "github.com/QuenumGerald/notify/notify", installed[1].PackageURL)
+	require.Empty(t, installed[1].Ref)
+}
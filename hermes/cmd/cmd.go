@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/ignite/cli/v29/ignite/services/plugin"
 
 	"github.com/ignite/apps/hermes/pkg/hermes"
@@ -34,6 +36,18 @@ func GetCommands() []*plugin.Command {
 							Persistent:   true,
 							Type:         plugin.FlagTypeString,
 						},
+						{
+							Name:       flagHermesBinary,
+							Usage:      "use a locally built or mirrored Hermes binary instead of downloading it",
+							Persistent: true,
+							Type:       plugin.FlagTypeString,
+						},
+						{
+							Name:       flagHermesMirror,
+							Usage:      "download the Hermes release archives and checksums from a mirror base URL (<mirror>/<version>/<asset>)",
+							Persistent: true,
+							Type:       plugin.FlagTypeString,
+						},
 					},
 					Commands: []*plugin.Command{
 						{
@@ -41,8 +55,73 @@ func GetCommands() []*plugin.Command {
 							Short: "Execute a hermes raw command",
 						},
 						{
-							Use:   "start [chain-a-id] [chain-a-rpc]",
-							Short: "Start the Hermes relayer",
+							Use:   "start [chain-a-id] [chain-b-id] [chain-n-id...]",
+							Short: "Start the Hermes relayer for all the configured chains",
+							Flags: plugin.Flags{
+								{
+									Name:         flagDetach,
+									DefaultValue: "false",
+									Usage:        "run the relayer in background, writing its logs into rotated log files",
+									Shorthand:    "d",
+									Type:         plugin.FlagTypeBool,
+								},
+								{
+									Name:         flagRestart,
+									DefaultValue: "false",
+									Usage:        "restart the relayer with backoff when it crashes",
+									Type:         plugin.FlagTypeBool,
+								},
+							},
+						},
+						{
+							Use:   "stop [chain-a-id] [chain-b-id] [chain-n-id...]",
+							Short: "Stop the Hermes relayer running in background",
+						},
+						{
+							Use:   "restart [chain-a-id] [chain-b-id] [chain-n-id...]",
+							Short: "Restart the Hermes relayer in background",
+							Flags: plugin.Flags{
+								{
+									Name:         flagRestart,
+									DefaultValue: "false",
+									Usage:        "restart the relayer with backoff when it crashes",
+									Type:         plugin.FlagTypeBool,
+								},
+							},
+						},
+						{
+							Use:   "logs [chain-a-id] [chain-b-id] [chain-n-id...]",
+							Short: "Show the logs of the Hermes relayer running in background",
+							Flags: plugin.Flags{
+								{
+									Name:         flagTail,
+									DefaultValue: "100",
+									Usage:        "number of lines to show from the end of the logs",
+									Shorthand:    "n",
+									Type:         plugin.FlagTypeUint64,
+								},
+								{
+									Name:         flagFollow,
+									DefaultValue: "false",
+									Usage:        "follow the new log lines",
+									Shorthand:    "f",
+									Type:         plugin.FlagTypeBool,
+								},
+							},
+						},
+						{
+							Use:   "status [chain-a-id] [chain-b-id] [chain-n-id...]",
+							Short: "Show the Hermes relayer health for the configured chains",
+							Long: "Show the relayer wallets and balances, the client expirations, " +
+								"the connections and channels states and the pending packets for the configured chains",
+							Flags: plugin.Flags{
+								{
+									Name:         flagJSON,
+									DefaultValue: "false",
+									Usage:        "print the status report as JSON",
+									Type:         plugin.FlagTypeBool,
+								},
+							},
 						},
 						{
 							Use:   "clear [command]",
@@ -58,6 +137,136 @@ func GetCommands() []*plugin.Command {
 								},
 							},
 						},
+						{
+							Use:   "test-transfer [src-chain-id] [dst-chain-id]",
+							Short: "Send an ICS-20 transfer between the relayer keys to check the relayer delivers it",
+							Long: `Send an ICS-20 transfer from the source chain relayer key to the destination chain relayer
+key through an open channel and wait for the relayer to deliver it, checking the IBC denom
+balance on the destination chain. The relayer must be running.`,
+							Flags: plugin.Flags{
+								{
+									Name:         flagPort,
+									DefaultValue: hermes.DefaultPortID,
+									Usage:        "source port id of the transfer",
+									Type:         plugin.FlagTypeString,
+								},
+								{
+									Name:  flagChannel,
+									Usage: "source channel id of the transfer (default the first open channel to the destination chain)",
+									Type:  plugin.FlagTypeString,
+								},
+								{
+									Name:         flagAmount,
+									DefaultValue: "1",
+									Usage:        "amount of tokens to transfer",
+									Type:         plugin.FlagTypeUint64,
+								},
+								{
+									Name:  flagDenom,
+									Usage: "denom of the tokens to transfer (default the source chain gas price denom)",
+									Type:  plugin.FlagTypeString,
+								},
+								{
+									Name:         flagTimeout,
+									DefaultValue: "2m",
+									Usage:        "time to wait for the relayer to deliver the transfer",
+									Type:         plugin.FlagTypeString,
+								},
+								{
+									Name:         flagPacketTimeout,
+									DefaultValue: "60s",
+									Usage:        "timeout of the transfer packet on the destination chain",
+									Type:         plugin.FlagTypeString,
+								},
+							},
+						},
+						{
+							Use:   "packets [command]",
+							Short: "Clear and inspect the IBC packets relayed by Hermes",
+							Long: `Clear and inspect the IBC packets relayed by Hermes.
+
+The config file is found from the chain ids, use the --config flag when more than one
+config relays the chains.`,
+							Flags: plugin.Flags{
+								{
+									Name:         flagJSON,
+									DefaultValue: "false",
+									Usage:        "print the result as JSON",
+									Persistent:   true,
+									Type:         plugin.FlagTypeBool,
+								},
+							},
+							Commands: []*plugin.Command{
+								{
+									Use:   "clear [chain-id] [port-id] [channel-id]",
+									Short: "Relay the pending packets and acknowledgments of a channel on both sides",
+								},
+								{
+									Use:   "pending [chain-id] [port-id] [channel-id]",
+									Short: "Query the pending packets and acknowledgments of a channel on both sides",
+								},
+								{
+									Use:   "commitments [chain-id] [port-id] [channel-id]",
+									Short: "Query the sequences of the packets sent and not yet acknowledged",
+								},
+								{
+									Use:   "acks [chain-id] [port-id] [channel-id]",
+									Short: "Query the sequences of the packets received and acknowledged",
+								},
+								{
+									Use:   "recv [dst-chain-id] [src-chain-id] [src-port-id] [src-channel-id]",
+									Short: "Relay the packets sent through the source channel to the destination chain",
+								},
+							},
+						},
+						{
+							Use:   "config [command]",
+							Short: "Manage the Hermes relayer config files",
+							Long: `Manage the Hermes relayer config files.
+
+The config file is found from the chain ids, use the --config flag when more than one
+config relays the chains.`,
+							Commands: []*plugin.Command{
+								{
+									Use:   "list [chain-id...]",
+									Short: "List the Hermes config files and their relayer status",
+								},
+								{
+									Use:   "show [chain-a-id] [chain-b-id] [chain-n-id...]",
+									Short: "Print a Hermes config file",
+								},
+								{
+									Use:   "edit [chain-a-id] [chain-b-id] [chain-n-id...]",
+									Short: "Edit a Hermes config file with the $EDITOR and validate the changes",
+								},
+								{
+									Use:   "remove [chain-a-id] [chain-b-id] [chain-n-id...]",
+									Short: "Remove a Hermes config file",
+								},
+								{
+									Use:   "add-chain [chain-id] [chain-rpc] [chain-grpc]",
+									Short: "Add a chain to an existing Hermes config file",
+									Long: `Add a chain to an existing Hermes config file.
+
+The config file is found from the --config-chains flag or the --config flag, the new chain
+is configured with the same flags used by the configure command for the chain B.`,
+									Flags: append(
+										chainCommandFlags(chainBFlags),
+										&plugin.Flag{
+											Name:  flagConfigChains,
+											Usage: "chain ids relayed by the config file to change",
+											Type:  plugin.FlagTypeStringSlice,
+										},
+										&plugin.Flag{
+											Name:         flagSkipChainQuery,
+											DefaultValue: "false",
+											Usage:        "do not query the chain to derive its account prefix, gas price, trusting period and event source",
+											Type:         plugin.FlagTypeBool,
+										},
+									),
+								},
+							},
+						},
 						{
 							Use:   "keys [command]",
 							Short: "Start the Hermes relayer",
@@ -70,6 +279,16 @@ func GetCommands() []*plugin.Command {
 									Use:   "file [chain-id] [filepath]",
 									Short: "Add a new key from a key file to Hermes relayer",
 								},
+								{
+									Use:   "import [chain-id] [account-name]",
+									Short: "Import an Ignite account or a keyring key as the Hermes relayer key",
+									Long: `Import an Ignite account or a keyring key as the Hermes relayer key.
+
+The key is exported from the Ignite account registry, or from a chain keyring when the
+--keyring-dir flag is set (e.g. ~/.mars), and written into the Hermes keyring with the
+chain key name from the relayer config.`,
+									Flags: keyringFlags(),
+								},
 								{
 									Use:   "list [chain-id]",
 									Short: "List Hermes relayer keys",
@@ -81,81 +300,24 @@ func GetCommands() []*plugin.Command {
 							},
 						},
 						{
-							Use:   "configure [chain-a-id] [chain-a-rpc] [chain-a-grpc] [chain-b-id] [chain-b-rpc] [chain-b-grpc]",
+							Use:   "configure [chain-a-id] [chain-a-rpc] [chain-a-grpc] [chain-b-id] [chain-b-rpc] [chain-b-grpc] [chain-n-id chain-n-rpc chain-n-grpc...]",
 							Short: "Configure the Hermes relayer creating the config file, client, channels and connection",
-							Flags: plugin.Flags{
-								{Name: flagChainAPortID, DefaultValue: "transfer", Usage: "port ID of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBPortID, DefaultValue: "transfer", Usage: "port ID of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainACCVConsumerChain, DefaultValue: "false", Usage: "only specify true if the chain A is a CCV consumer", Type: plugin.FlagTypeBool},
-								{Name: flagChainBCCVConsumerChain, DefaultValue: "false", Usage: "only specify true if the chain B is a CCV consumer", Type: plugin.FlagTypeBool},
-								{Name: flagChainAEventSourceURL, Usage: "WS event source url of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBEventSourceURL, Usage: "WS event source url of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAEventSourceMode, DefaultValue: "push", Usage: "WS event source mode of the chain A (event source url should be set to use this flag)", Type: plugin.FlagTypeString},
-								{Name: flagChainBEventSourceMode, DefaultValue: "push", Usage: "WS event source mode of the chain B (event source url should be set to use this flag)", Type: plugin.FlagTypeString},
-								{Name: flagChainAEventSourceBatchDelay, DefaultValue: "500ms", Usage: "WS event source batch delay time of the chain A (event source url should be set to use this flag)", Type: plugin.FlagTypeString},
-								{Name: flagChainBEventSourceBatchDelay, DefaultValue: "500ms", Usage: "WS event source batch delay time of the chain B (event source url should be set to use this flag)", Type: plugin.FlagTypeString},
-								{Name: flagChainARPCTimeout, DefaultValue: "10s", Usage: "RPC timeout of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBRPCTimeout, DefaultValue: "10s", Usage: "RPC timeout of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainATrustedNode, DefaultValue: "false", Usage: "enable trusted node on the chain A", Type: plugin.FlagTypeBool},
-								{Name: flagChainBTrustedNode, DefaultValue: "false", Usage: "enable trusted node on the chain B", Type: plugin.FlagTypeBool},
-								{Name: flagChainAAccountPrefix, DefaultValue: "cosmos", Usage: "account prefix of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBAccountPrefix, DefaultValue: "cosmos", Usage: "account prefix of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAKeyName, DefaultValue: "wallet", Usage: "hermes account name of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBKeyName, DefaultValue: "wallet", Usage: "hermes account name of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAAddressType, DefaultValue: "cosmos", Usage: "address type of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBAddressType, DefaultValue: "cosmos", Usage: "address type of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAKeyStoreType, DefaultValue: "Test", Usage: "address type of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainBKeyStoreType, DefaultValue: "Test", Usage: "address type of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAStorePrefix, DefaultValue: "ibc", Usage: "key store type of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBStorePrefix, DefaultValue: "ibc", Usage: "key store type of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainADefaultGas, DefaultValue: "100000", Usage: "default gas used for transactions on chain A", Type: plugin.FlagTypeUint64},
-								{Name: flagChainBDefaultGas, DefaultValue: "100000", Usage: "default gas used for transactions on chain B", Type: plugin.FlagTypeUint64},
-								{Name: flagChainAMaxGas, DefaultValue: "400000", Usage: "max gas used for transactions on chain A", Type: plugin.FlagTypeUint64},
-								{Name: flagChainBMaxGas, DefaultValue: "400000", Usage: "max gas used for transactions on chain B", Type: plugin.FlagTypeUint64},
-								{Name: flagChainAGasPrice, DefaultValue: "0.025stake", Usage: "gas price used for transactions on chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBGasPrice, DefaultValue: "0.025stake", Usage: "gas price used for transactions on chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAGasMultiplier, DefaultValue: "1.1", Usage: "gas multiplier used for transactions on chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBGasMultiplier, DefaultValue: "1.1", Usage: "gas multiplier used for transactions on chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAMaxMsgNum, DefaultValue: "30", Usage: "max message number used for transactions on chain A", Type: plugin.FlagTypeUint64},
-								{Name: flagChainBMaxMsgNum, DefaultValue: "30", Usage: "max message number used for transactions on chain B", Type: plugin.FlagTypeUint64},
-								{Name: flagChainAMaxTxSize, DefaultValue: "2097152", Usage: "max transaction size on chain A", Type: plugin.FlagTypeUint64},
-								{Name: flagChainBMaxTxSize, DefaultValue: "2097152", Usage: "max transaction size on chain B", Type: plugin.FlagTypeUint64},
-								{Name: flagChainAClockDrift, DefaultValue: "5s", Usage: "clock drift of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBClockDrift, DefaultValue: "5s", Usage: "clock drift of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAMaxBlockTime, DefaultValue: "30s", Usage: "maximum block time of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBMaxBlockTime, DefaultValue: "30s", Usage: "maximum block time of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainATrustingPeriod, DefaultValue: "14days", Usage: "trusting period of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBTrustingPeriod, DefaultValue: "14days", Usage: "trusting period of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainATrustThreshold, DefaultValue: "2/3", Usage: "trust threshold of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBTrustThreshold, DefaultValue: "2/3", Usage: "trust threshold of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAMemoPrefix, Usage: "memo prefix of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBMemoPrefix, Usage: "memo prefix of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAFaucet, Usage: "faucet URL of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBFaucet, Usage: "faucet URL of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainAType, DefaultValue: "CosmosSdk", Usage: "type of the chain A", Type: plugin.FlagTypeString},
-								{Name: flagChainBType, DefaultValue: "CosmosSdk", Usage: "type of the chain B", Type: plugin.FlagTypeString},
-								{Name: flagChainASequentialBatchTx, DefaultValue: "false", Usage: "enable sequential batch transaction on the chain A", Type: plugin.FlagTypeBool},
-								{Name: flagChainBSequentialBatchTx, DefaultValue: "false", Usage: "enable sequential batch transaction on the chain B", Type: plugin.FlagTypeBool},
-								{Name: flagTelemetryEnabled, DefaultValue: "false", Usage: "enable hermes telemetry", Type: plugin.FlagTypeBool},
-								{Name: flagTelemetryHost, DefaultValue: "127.0.0.1", Usage: "hermes telemetry host", Type: plugin.FlagTypeString},
-								{Name: flagTelemetryPort, DefaultValue: "3001", Usage: "hermes telemetry port", Type: plugin.FlagTypeUint64},
-								{Name: flagRestEnabled, DefaultValue: "false", Usage: "enable hermes rest", Type: plugin.FlagTypeBool},
-								{Name: flagRestHost, DefaultValue: "127.0.0.1", Usage: "hermes rest host", Type: plugin.FlagTypeString},
-								{Name: flagRestPort, DefaultValue: "3000", Usage: "hermes rest port", Type: plugin.FlagTypeUint64},
-								{Name: flagModeChannelsEnabled, DefaultValue: "true", Usage: "enable hermes channels", Type: plugin.FlagTypeBool},
-								{Name: flagModeClientsEnabled, DefaultValue: "true", Usage: "enable hermes clients", Type: plugin.FlagTypeBool},
-								{Name: flagModeClientsMisbehaviour, DefaultValue: "true", Usage: "enable hermes clients misbehaviour", Type: plugin.FlagTypeBool},
-								{Name: flagModeClientsRefresh, DefaultValue: "true", Usage: "enable hermes client refresh time", Type: plugin.FlagTypeBool},
-								{Name: flagModeConnectionsEnabled, DefaultValue: "true", Usage: "enable hermes connections", Type: plugin.FlagTypeBool},
-								{Name: flagModePacketsEnabled, DefaultValue: "true", Usage: "enable hermes packets", Type: plugin.FlagTypeBool},
-								{Name: flagModePacketsClearInterval, DefaultValue: "100", Usage: "hermes packet clear interval", Type: plugin.FlagTypeUint64},
-								{Name: flagModePacketsClearOnStart, DefaultValue: "true", Usage: "enable hermes packets clear on start", Type: plugin.FlagTypeBool},
-								{Name: flagModePacketsTxConfirmation, DefaultValue: "true", Usage: "hermes packet transaction confirmation", Type: plugin.FlagTypeBool},
-								{Name: flagAutoRegisterCounterpartyPayee, DefaultValue: "false", Usage: "auto register the counterparty payee on a destination chain to the relayer's address on the source chain", Type: plugin.FlagTypeBool},
-								{Name: flagGenerateWallets, DefaultValue: "false", Usage: "automatically generate wallets if they do not exist", Type: plugin.FlagTypeBool},
-								{Name: flagOverwriteConfig, DefaultValue: "false", Usage: "overwrite the current config if it already exists", Type: plugin.FlagTypeBool},
-								{Name: flagChannelVersion, Usage: "set the channel version for the create channel hermes command", Type: plugin.FlagTypeString},
-							},
+							Long: `Configure the Hermes relayer creating the config file, client, channels and connection.
+
+Any number of chains can be added into the same config, the chain A flags configure the first
+chain and the chain B flags configure all the other chains, the per-chain settings (event source,
+account prefix, key, gas price, faucet, account, registry and Ignite config) of more than two
+chains must be set in a relayer spec file. The relayed paths are set with the
+--path flag in the <chain-a-id>[/<port-id>]:<chain-b-id>[/<port-id>] format, by default the
+first chain is relayed with each one of the other chains.
+
+The chains, keys, faucets and paths can also be declared into a YAML or TOML relayer spec file
+passed with the --from flag.
+
+Existing open channels and connections and active clients between the chains are reused, so the
+command can be run again without leaving orphaned clients and connections. Use the --force-new
+flag to always create new ones.`,
+							Flags: configureFlags(),
 						},
 					},
 				},
@@ -163,3 +325,88 @@ func GetCommands() []*plugin.Command {
 		},
 	}
 }
+
+// configureFlags returns the configure command flags.
+func configureFlags() plugin.Flags {
+	flags := plugin.Flags{
+		{Name: flagChainAPortID, DefaultValue: "transfer", Usage: "port ID of the chain A", Type: plugin.FlagTypeString},
+		{Name: flagChainBPortID, DefaultValue: "transfer", Usage: "port ID of the chain B", Type: plugin.FlagTypeString},
+	}
+
+	// chain A and B flags are listed in pairs
+	chainA, chainB := chainCommandFlags(chainAFlags), chainCommandFlags(chainBFlags)
+	for i := range chainA {
+		flags = append(flags, chainA[i], chainB[i])
+	}
+
+	flags = append(flags, plugin.Flags{
+		{Name: flagChainAFaucet, Usage: "faucet URL of the chain A", Type: plugin.FlagTypeString},
+		{Name: flagChainBFaucet, Usage: "faucet URL of the chain B", Type: plugin.FlagTypeString},
+		{Name: flagChainAAccount, Usage: "Ignite account or keyring key name imported as the chain A relayer key", Type: plugin.FlagTypeString},
+		{Name: flagChainBAccount, Usage: "Ignite account or keyring key name imported as the chain B relayer key", Type: plugin.FlagTypeString},
+		{Name: flagTelemetryEnabled, DefaultValue: "false", Usage: "enable hermes telemetry", Type: plugin.FlagTypeBool},
+		{Name: flagTelemetryHost, DefaultValue: "127.0.0.1", Usage: "hermes telemetry host", Type: plugin.FlagTypeString},
+		{Name: flagTelemetryPort, DefaultValue: "3001", Usage: "hermes telemetry port", Type: plugin.FlagTypeUint64},
+		{Name: flagRestEnabled, DefaultValue: "false", Usage: "enable hermes rest", Type: plugin.FlagTypeBool},
+		{Name: flagRestHost, DefaultValue: "127.0.0.1", Usage: "hermes rest host", Type: plugin.FlagTypeString},
+		{Name: flagRestPort, DefaultValue: "3000", Usage: "hermes rest port", Type: plugin.FlagTypeUint64},
+		{Name: flagModeChannelsEnabled, DefaultValue: "true", Usage: "enable hermes channels", Type: plugin.FlagTypeBool},
+		{Name: flagModeClientsEnabled, DefaultValue: "true", Usage: "enable hermes clients", Type: plugin.FlagTypeBool},
+		{Name: flagModeClientsMisbehaviour, DefaultValue: "true", Usage: "enable hermes clients misbehaviour", Type: plugin.FlagTypeBool},
+		{Name: flagModeClientsRefresh, DefaultValue: "true", Usage: "enable hermes client refresh time", Type: plugin.FlagTypeBool},
+		{Name: flagModeConnectionsEnabled, DefaultValue: "true", Usage: "enable hermes connections", Type: plugin.FlagTypeBool},
+		{Name: flagModePacketsEnabled, DefaultValue: "true", Usage: "enable hermes packets", Type: plugin.FlagTypeBool},
+		{Name: flagModePacketsClearInterval, DefaultValue: "100", Usage: "hermes packet clear interval", Type: plugin.FlagTypeUint64},
+		{Name: flagModePacketsClearOnStart, DefaultValue: "true", Usage: "enable hermes packets clear on start", Type: plugin.FlagTypeBool},
+		{Name: flagModePacketsTxConfirmation, DefaultValue: "true", Usage: "hermes packet transaction confirmation", Type: plugin.FlagTypeBool},
+		{Name: flagAutoRegisterCounterpartyPayee, DefaultValue: "false", Usage: "auto register the counterparty payee on a destination chain to the relayer's address on the source chain", Type: plugin.FlagTypeBool},
+		{Name: flagGenerateWallets, DefaultValue: "false", Usage: "automatically generate wallets if they do not exist", Type: plugin.FlagTypeBool},
+		{Name: flagOverwriteConfig, DefaultValue: "false", Usage: "overwrite the current config if it already exists", Type: plugin.FlagTypeBool},
+		{Name: flagChannelVersion, Usage: "set the channel version for the create channel hermes command", Type: plugin.FlagTypeString},
+		{Name: flagFrom, Usage: "declarative YAML or TOML relayer spec file describing the chains, keys, faucets and paths", Type: plugin.FlagTypeString},
+		{Name: flagSkipChainQuery, DefaultValue: "false", Usage: "do not query the chains to derive their account prefix, gas price, trusting period and event source", Type: plugin.FlagTypeBool},
+		{Name: flagForceNew, DefaultValue: "false", Usage: "always create new clients, connections and channels instead of reusing the existing ones", Type: plugin.FlagTypeBool},
+		{Name: flagPath, Usage: "path to be relayed in the <chain-a-id>[/<port-id>]:<chain-b-id>[/<port-id>] format (default to the first chain relayed with each one of the other chains)", Type: plugin.FlagTypeStringSlice},
+	}...)
+	return append(flags, keyringFlags()...)
+}
+
+// keyringFlags returns the flags to find the accounts imported as relayer keys.
+func keyringFlags() plugin.Flags {
+	return plugin.Flags{
+		{Name: flagKeyringBackend, DefaultValue: hermes.DefaultKeyringBackend, Usage: "keyring backend of the imported accounts", Type: plugin.FlagTypeString},
+		{Name: flagKeyringDir, Usage: "keyring directory of the imported accounts, the Ignite accounts by default", Type: plugin.FlagTypeString},
+	}
+}
+
+// chainCommandFlags returns the command flags configuring a chain.
+func chainCommandFlags(f chainFlags) plugin.Flags {
+	return plugin.Flags{
+		{Name: f.ccvConsumerChain, DefaultValue: "false", Usage: fmt.Sprintf("only specify true if the chain %s is a CCV consumer", f.name), Type: plugin.FlagTypeBool},
+		{Name: f.eventSourceURL, Usage: fmt.Sprintf("WS event source url of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.eventSourceMode, DefaultValue: "push", Usage: fmt.Sprintf("WS event source mode of the chain %s (event source url should be set to use this flag)", f.name), Type: plugin.FlagTypeString},
+		{Name: f.eventSourceBatchDelay, DefaultValue: "500ms", Usage: fmt.Sprintf("WS event source batch delay time of the chain %s (event source url should be set to use this flag)", f.name), Type: plugin.FlagTypeString},
+		{Name: f.rpcTimeout, DefaultValue: "10s", Usage: fmt.Sprintf("RPC timeout of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.trustedNode, DefaultValue: "false", Usage: fmt.Sprintf("enable trusted node on the chain %s", f.name), Type: plugin.FlagTypeBool},
+		{Name: f.accountPrefix, DefaultValue: "cosmos", Usage: fmt.Sprintf("account prefix of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.keyName, DefaultValue: "wallet", Usage: fmt.Sprintf("hermes account name of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.addressType, DefaultValue: "cosmos", Usage: fmt.Sprintf("address type of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.keyStoreType, DefaultValue: "Test", Usage: fmt.Sprintf("key store type of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.storePrefix, DefaultValue: "ibc", Usage: fmt.Sprintf("store prefix of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.defaultGas, DefaultValue: "100000", Usage: fmt.Sprintf("default gas used for transactions on chain %s", f.name), Type: plugin.FlagTypeUint64},
+		{Name: f.maxGas, DefaultValue: "400000", Usage: fmt.Sprintf("max gas used for transactions on chain %s", f.name), Type: plugin.FlagTypeUint64},
+		{Name: f.gasPrice, DefaultValue: "0.025stake", Usage: fmt.Sprintf("gas price used for transactions on chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.gasMultiplier, DefaultValue: "1.1", Usage: fmt.Sprintf("gas multiplier used for transactions on chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.maxMsgNum, DefaultValue: "30", Usage: fmt.Sprintf("max message number used for transactions on chain %s", f.name), Type: plugin.FlagTypeUint64},
+		{Name: f.maxTxSize, DefaultValue: "2097152", Usage: fmt.Sprintf("max transaction size on chain %s", f.name), Type: plugin.FlagTypeUint64},
+		{Name: f.clockDrift, DefaultValue: "5s", Usage: fmt.Sprintf("clock drift of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.maxBlockTime, DefaultValue: "30s", Usage: fmt.Sprintf("maximum block time of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.trustingPeriod, DefaultValue: "14days", Usage: fmt.Sprintf("trusting period of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.trustThreshold, DefaultValue: "2/3", Usage: fmt.Sprintf("trust threshold of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.memoPrefix, Usage: fmt.Sprintf("memo prefix of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.chainType, DefaultValue: "CosmosSdk", Usage: fmt.Sprintf("type of the chain %s", f.name), Type: plugin.FlagTypeString},
+		{Name: f.sequentialBatchTx, DefaultValue: "false", Usage: fmt.Sprintf("enable sequential batch transaction on the chain %s", f.name), Type: plugin.FlagTypeBool},
+		{Name: f.registry, Usage: fmt.Sprintf("cosmos chain registry chain.json file or URL of the chain %s, used to derive its account prefix and gas price", f.name), Type: plugin.FlagTypeString},
+		{Name: f.igniteConfig, Usage: fmt.Sprintf("Ignite config.yml file of the chain %s, used to derive its gas price and trusting period", f.name), Type: plugin.FlagTypeString},
+	}
+}
@@ -0,0 +1,333 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+	"github.com/manifoldco/promptui"
+
+	"github.com/ignite/apps/hermes/pkg/hermes"
+)
+
+var configListHeader = []string{"Config", "Chains", "Relayer", "Path"}
+
+func ConfigListHandler(_ context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args    = cmd.Args
+		session = cliui.New()
+	)
+	defer session.End()
+
+	cfgPaths, err := hermes.FindConfigs(args...)
+	if err != nil {
+		return err
+	}
+	if len(cfgPaths) == 0 {
+		return session.Println(color.Yellow.Sprint("No hermes config found, try to configure you relayer first"))
+	}
+
+	entries := make([][]string, 0, len(cfgPaths))
+	for _, cfgPath := range cfgPaths {
+		chainIDs := "-"
+		if cfg, err := hermes.LoadConfig(cfgPath); err == nil {
+			chainIDs = strings.Join(cfg.Chains.ChainIDs(), ", ")
+		}
+
+		relayer := "stopped"
+		daemon, err := hermes.NewDaemon(cfgPath)
+		if err != nil {
+			return err
+		}
+		if pid, err := daemon.PID(); err == nil {
+			relayer = fmt.Sprintf("running (pid %d)", pid)
+		}
+
+		entries = append(entries, []string{filepath.Base(cfgPath), chainIDs, relayer, cfgPath})
+	}
+	return session.PrintTable(configListHeader, entries...)
+}
+
+func ConfigShowHandler(_ context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args  = cmd.Args
+		flags = plugin.Flags(cmd.Flags)
+	)
+
+	cfgPath, err := findConfigPath(flags, args...)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+func ConfigEditHandler(_ context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args    = cmd.Args
+		flags   = plugin.Flags(cmd.Flags)
+		session = cliui.New()
+	)
+	defer session.End()
+
+	cfgPath, err := findConfigPath(flags, args...)
+	if err != nil {
+		return err
+	}
+	original, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return err
+	}
+
+	// edit a temporary copy, so the config is only changed when valid
+	tmp, err := os.CreateTemp("", "hermes-config-*.toml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(original); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	var (
+		cfg    *hermes.Config
+		edited []byte
+	)
+	for {
+		if err := editFile(tmp.Name()); err != nil {
+			return err
+		}
+		if edited, err = os.ReadFile(tmp.Name()); err != nil {
+			return err
+		}
+		if cfg, err = hermes.ParseConfig(edited); err == nil {
+			break
+		}
+
+		_ = session.Println(color.Red.Sprintf("Invalid config: %s", err))
+		if err := session.AskConfirm("Do you want to edit the config again"); err != nil {
+			if errors.Is(err, promptui.ErrAbort) {
+				return errors.New("config not changed")
+			}
+			return err
+		}
+	}
+
+	diff := hermes.Diff(string(original), string(edited))
+	if diff == "" {
+		return session.Println("No changes made to the config")
+	}
+	if err := confirmDiff(session, diff, "Do you want to save the config changes"); err != nil {
+		return err
+	}
+
+	// write the edited file as is to keep the user formatting and comments
+	savedPath, err := configSavePath(flags, cfg, cfgPath)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(savedPath, edited, 0o644); err != nil {
+		return err
+	}
+	if savedPath != cfgPath {
+		if err := os.Remove(cfgPath); err != nil {
+			return err
+		}
+	}
+	return session.Println(color.Green.Sprintf("Hermes config saved at %s", savedPath))
+}
+
+func ConfigRemoveHandler(_ context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args    = cmd.Args
+		flags   = plugin.Flags(cmd.Flags)
+		session = cliui.New()
+	)
+	defer session.End()
+
+	cfgPath, err := findConfigPath(flags, args...)
+	if err != nil {
+		return err
+	}
+	if err := ensureRelayerStopped(cfgPath); err != nil {
+		return err
+	}
+
+	if err := session.AskConfirm(fmt.Sprintf("Do you want to remove the config %s", cfgPath)); err != nil {
+		if errors.Is(err, promptui.ErrAbort) {
+			return errors.New("config not removed")
+		}
+		return err
+	}
+	if err := os.Remove(cfgPath); err != nil {
+		return err
+	}
+	return session.Println(color.Green.Sprintf("Hermes config %s removed", cfgPath))
+}
+
+func ConfigAddChainHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args            = cmd.Args
+		flags           = plugin.Flags(cmd.Flags)
+		configChains, _ = flags.GetStringSlice(flagConfigChains)
+		session         = cliui.New()
+	)
+	defer session.End()
+
+	if len(args) != 3 {
+		return errors.New("expected the chain id, rpc address and grpc address args")
+	}
+	chainID, rpcAddr, grpcAddr := args[0], args[1], args[2]
+
+	cfgPath, err := findConfigPath(flags, configChains...)
+	if err != nil {
+		return err
+	}
+	cfg, err := hermes.LoadConfig(cfgPath)
+	if err != nil {
+		return err
+	}
+	original, err := cfg.Encode()
+	if err != nil {
+		return err
+	}
+
+	metadata, err := chainMetadata(ctx, session, flags, chainBFlags, chainID, rpcAddr)
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	options, err := newChainOptions(flags, explicitFlags(cmd), chainBFlags, metadata)
+	if err != nil {
+		return err
+	}
+	if _, err := cfg.AddChain(chainID, rpcAddr, grpcAddr, options...); err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	updated, err := cfg.Encode()
+	if err != nil {
+		return err
+	}
+
+	if err := confirmDiff(session, hermes.Diff(string(original), string(updated)), "Do you want to add the chain to the config"); err != nil {
+		return err
+	}
+
+	savedPath, err := configSavePath(flags, cfg, cfgPath)
+	if err != nil {
+		return err
+	}
+	if err := cfg.WriteFile(savedPath); err != nil {
+		return err
+	}
+	if savedPath != cfgPath {
+		if err := os.Remove(cfgPath); err != nil {
+			return err
+		}
+	}
+
+	_ = session.Println(color.Green.Sprintf("Chain %s added to the hermes config %s", chainID, savedPath))
+	return session.Printf(
+		"Add the relayer key with %s and configure the new paths to relay the chain\n",
+		color.Yellow.Sprintf("ignite relayer hermes keys add %s [mnemonic]", chainID),
+	)
+}
+
+// configSavePath returns the path to save the config. Custom config files are
+// saved in place and the other configs are named by their chains, so they keep
+// being found by the chain ids.
+func configSavePath(flags plugin.Flags, cfg *hermes.Config, cfgPath string) (string, error) {
+	if getConfig(flags) != "" {
+		return cfgPath, nil
+	}
+
+	savePath, err := cfg.ConfigPath()
+	if err != nil {
+		return "", err
+	}
+	if savePath == cfgPath {
+		return cfgPath, nil
+	}
+
+	if err := ensureRelayerStopped(cfgPath); err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(savePath); err == nil {
+		return "", errors.Errorf("config file %s already exist", savePath)
+	}
+	return savePath, nil
+}
+
+// ensureRelayerStopped returns an error if the relayer for the config is running in background.
+func ensureRelayerStopped(cfgPath string) error {
+	daemon, err := hermes.NewDaemon(cfgPath)
+	if err != nil {
+		return err
+	}
+	if pid, err := daemon.PID(); err == nil {
+		return errors.Errorf("relayer %s is running (pid %d), stop it first", daemon.Name(), pid)
+	}
+	return nil
+}
+
+// confirmDiff prints the config changes and asks to confirm them.
+func confirmDiff(session *cliui.Session, diff, question string) error {
+	_ = session.Println("Config changes:")
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			line = color.Green.Sprint(line)
+		case strings.HasPrefix(line, "-"):
+			line = color.Red.Sprint(line)
+		}
+		_ = session.Println(line)
+	}
+
+	if err := session.AskConfirm(question); err != nil {
+		if errors.Is(err, promptui.ErrAbort) {
+			return errors.New("config not changed")
+		}
+		return err
+	}
+	return nil
+}
+
+// editFile opens the file with the user editor, from the VISUAL or EDITOR env vars.
+func editFile(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// the editor might have arguments, e.g. "code --wait"
+	fields := strings.Fields(editor)
+	cmd := exec.Command(fields[0], append(fields[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("failed to run the editor %s: %w", editor, err)
+	}
+	return nil
+}
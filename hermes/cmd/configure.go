@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/bubbleconfirm"
 
@@ -20,6 +21,28 @@ import (
 	"github.com/ignite/apps/hermes/pkg/hermes"
 )
 
+type (
+	// chainArgs represents the chain endpoints passed as command args.
+	chainArgs struct {
+		id       string
+		rpcAddr  string
+		grpcAddr string
+	}
+
+	// chainAccount represents the relayer account setup of a chain.
+	chainAccount struct {
+		chainID        string
+		faucet         string
+		mnemonic       string
+		mnemonicFile   string
+		account        string
+		keyringBackend string
+		keyringDir     string
+		// faucetHint tells how to set the chain faucet address.
+		faucetHint string
+	}
+)
+
 func ConfigureHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	var (
 		args  = cmd.Args
@@ -30,16 +53,10 @@ func ConfigureHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	defer session.End()
 
 	var (
-		chainAID = args[0]
-		chainBID = args[3]
-
 		generateWallets, _ = flags.GetBool(flagGenerateWallets)
 		overwriteConfig, _ = flags.GetBool(flagOverwriteConfig)
-		chainAPortID, _    = flags.GetString(flagChainAPortID)
-		chainAFaucet, _    = flags.GetString(flagChainAFaucet)
-		chainBPortID, _    = flags.GetString(flagChainBPortID)
-		chainBFaucet, _    = flags.GetString(flagChainBFaucet)
-		channelVersion, _  = flags.GetString(flagChannelVersion)
+		specFile, _        = flags.GetString(flagFrom)
+		forceNew, _        = flags.GetBool(flagForceNew)
 		customCfg          = getConfig(flags)
 	)
 
@@ -48,23 +65,69 @@ func ConfigureHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 		return err
 	}
 
-	var hermesCfg *hermes.Config
-	if customCfg != "" {
+	var (
+		hermesCfg *hermes.Config
+		paths     hermes.Paths
+		accounts  []chainAccount
+	)
+	switch {
+	case specFile != "":
+		spec, err := hermes.LoadSpec(specFile)
+		if err != nil {
+			return err
+		}
+		hermesCfg, err = spec.Config(newConfigOptions(flags)...)
+		if err != nil {
+			return err
+		}
+		paths, err = spec.RelayPaths()
+		if err != nil {
+			return err
+		}
+		accounts = specChainAccounts(spec)
+	case customCfg != "":
 		hermesCfg, err = hermes.LoadConfig(customCfg)
 		if err != nil {
 			return err
 		}
-	} else {
-		hermesCfg, err = newHermesConfig(flags, args)
+		if err := validateChainFlags(explicitFlags(cmd), len(hermesCfg.Chains)); err != nil {
+			return err
+		}
+		paths, err = getPaths(flags, hermesCfg)
+		if err != nil {
+			return err
+		}
+	default:
+		chains, err := parseChainArgs(args)
+		if err != nil {
+			return err
+		}
+		explicit := explicitFlags(cmd)
+		if err := validateChainFlags(explicit, len(chains)); err != nil {
+			return err
+		}
+		hermesCfg, err = newHermesConfig(ctx, session, flags, explicit, chains)
 		if err != nil {
 			return err
 		}
+		paths, err = getPaths(flags, hermesCfg)
+		if err != nil {
+			return err
+		}
+	}
+	if err := hermesCfg.ValidatePaths(paths); err != nil {
+		return err
 	}
+	if accounts == nil {
+		accounts = flagChainAccounts(flags, hermesCfg.Chains.ChainIDs())
+	}
+
 	cfgPath, err := hermesCfg.ConfigPath()
 	if err != nil {
 		return err
 	}
 
+	chainIDs := hermesCfg.Chains.ChainIDs()
 	if _, err := os.Stat(cfgPath); overwriteConfig || os.IsNotExist(err) {
 		if err := hermesCfg.Save(); err != nil {
 			return err
@@ -72,9 +135,8 @@ func ConfigureHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	} else {
 		session.StopSpinner()
 		if err := session.AskConfirm(fmt.Sprintf(
-			"Hermes %s <-> %s config already exist at %s. Do you want to reuse this config file",
-			chainAID,
-			chainBID,
+			"Hermes %s config already exist at %s. Do you want to reuse this config file",
+			strings.Join(chainIDs, " <-> "),
 			cfgPath,
 		)); err != nil {
 			if !errors.Is(err, promptui.ErrAbort) {
@@ -90,166 +152,129 @@ func ConfigureHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	_ = session.Println(color.Green.Sprintf("Hermes config created at %s", cfgPath))
 
 	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
-	h, err := hermes.New(hermesVersion)
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
 	if err != nil {
 		return err
 	}
 
-	session.StartSpinner(fmt.Sprintf("Verifying chain A (%s) keys", chainAID))
+	for _, account := range accounts {
+		session.StartSpinner(fmt.Sprintf("Verifying chain %s keys", account.chainID))
+		if err := ensureAccount(
+			ctx,
+			session,
+			hermesCfg,
+			h,
+			account,
+			cfgPath,
+			generateWallets,
+		); err != nil {
+			return err
+		}
+	}
 
-	if err := ensureAccount(
-		ctx,
-		session,
-		hermesCfg,
-		h,
-		chainAID,
-		chainAFaucet,
-		cfgPath,
-		generateWallets,
-	); err != nil {
-		return err
+	for _, path := range paths {
+		if err := createPath(ctx, session, h, cfgPath, path, forceNew); err != nil {
+			return err
+		}
 	}
 
-	session.StartSpinner(fmt.Sprintf("Verifying chain B (%s) keys", chainBID))
+	return nil
+}
 
-	if err := ensureAccount(
-		ctx,
-		session,
-		hermesCfg,
-		h,
-		chainBID,
-		chainBFaucet,
-		cfgPath,
-		generateWallets,
-	); err != nil {
-		return err
+// parseChainArgs parses the chain id, RPC and gRPC addresses from the command args.
+func parseChainArgs(args []string) ([]chainArgs, error) {
+	if len(args) < 6 || len(args)%3 != 0 {
+		return nil, errors.Errorf(
+			"expected [chain-id] [chain-rpc] [chain-grpc] args for at least two chains, got %d args",
+			len(args),
+		)
 	}
 
-	// create client A
-	session.StartSpinner("Creating client A")
-	var (
-		bufClientAResult = bytes.Buffer{}
-		clientAResult    = hermes.ClientResult{}
-	)
-	if err := h.CreateClient(
-		ctx,
-		chainAID,
-		chainBID,
-		hermes.WithConfigFile(cfgPath),
-		hermes.WithStdOut(&bufClientAResult),
-		hermes.WithJSONOutput(),
-	); err != nil {
-		return err
-	}
-	if err := hermes.UnmarshalResult(bufClientAResult.Bytes(), &clientAResult); err != nil {
-		return err
+	chains := make([]chainArgs, 0, len(args)/3)
+	for i := 0; i < len(args); i += 3 {
+		chains = append(chains, chainArgs{
+			id:       args[i],
+			rpcAddr:  args[i+1],
+			grpcAddr: args[i+2],
+		})
 	}
+	return chains, nil
+}
 
-	session.StopSpinner()
-	_ = session.Println(color.Green.Sprintf(
-		"Client '%s' created (%s -> %s)",
-		clientAResult.CreateClient.ClientID,
-		chainAID,
-		chainBID,
-	))
-
-	// create client B
-	session.StartSpinner("Creating client B")
+// getPaths returns the paths to be relayed from the path flag. If no path is
+// set, the first chain is relayed with each one of the other chains.
+func getPaths(flags plugin.Flags, cfg *hermes.Config) (hermes.Paths, error) {
 	var (
-		bufClientBResult = bytes.Buffer{}
-		clientBResult    = hermes.ClientResult{}
+		pathsFlag, _      = flags.GetStringSlice(flagPath)
+		portA, _          = flags.GetString(flagChainAPortID)
+		portB, _          = flags.GetString(flagChainBPortID)
+		channelVersion, _ = flags.GetString(flagChannelVersion)
+		chainIDs          = cfg.Chains.ChainIDs()
 	)
-	if err := h.CreateClient(
-		ctx,
-		chainBID,
-		chainAID,
-		hermes.WithConfigFile(cfgPath),
-		hermes.WithStdOut(&bufClientBResult),
-		hermes.WithJSONOutput(),
-	); err != nil {
-		return err
-	}
-	if err := hermes.UnmarshalResult(bufClientBResult.Bytes(), &clientBResult); err != nil {
-		return err
-	}
 
-	session.StopSpinner()
-	_ = session.Println(color.Green.Sprintf(
-		"Client %s' created (%s -> %s)",
-		clientBResult.CreateClient.ClientID,
-		chainBID,
-		chainAID,
-	))
-
-	// create connection
-	session.StartSpinner("Creating connection")
-	var (
-		bufConnection = bytes.Buffer{}
-		connection    = hermes.ConnectionResult{}
-	)
-	if err := h.CreateConnection(
-		ctx,
-		chainAID,
-		clientAResult.CreateClient.ClientID,
-		clientBResult.CreateClient.ClientID,
-		hermes.WithConfigFile(cfgPath),
-		hermes.WithStdOut(&bufConnection),
-		hermes.WithJSONOutput(),
-	); err != nil {
-		return err
+	paths := make(hermes.Paths, 0, len(pathsFlag))
+	if len(pathsFlag) == 0 && len(chainIDs) > 0 {
+		paths = hermes.HubPaths(chainIDs[0], chainIDs, portA, portB)
 	}
-	if err := hermes.UnmarshalResult(bufConnection.Bytes(), &connection); err != nil {
-		return err
-	}
-
-	session.StopSpinner()
-	_ = session.Println(color.Green.Sprintf(
-		"Connection '%s (%s) <-> %s (%s)' created",
-		chainAID,
-		connection.ASide.ConnectionID,
-		chainBID,
-		connection.BSide.ConnectionID,
-	))
-
-	// create and query channel
-	session.StartSpinner("Creating channel")
-	var (
-		bufChannel     = bytes.Buffer{}
-		channel        = hermes.ConnectionResult{}
-		createChanOpts = []hermes.Option{
-			hermes.WithConfigFile(cfgPath),
-			hermes.WithStdOut(&bufChannel),
-			hermes.WithJSONOutput(),
+	for _, p := range pathsFlag {
+		path, err := hermes.ParsePath(p, portA, portB)
+		if err != nil {
+			return nil, err
 		}
-	)
-	if channelVersion != "" {
-		createChanOpts = append(createChanOpts, hermes.WithFlags(hermes.Flags{flagChannelVersion: channelVersion}))
-	}
-
-	if err := h.CreateChannel(
-		ctx,
-		chainAID,
-		connection.ASide.ConnectionID,
-		chainAPortID,
-		chainBPortID,
-		createChanOpts...,
-	); err != nil {
-		return err
+		paths = append(paths, path)
 	}
-	if err := hermes.UnmarshalResult(bufChannel.Bytes(), &channel); err != nil {
-		return err
+	for i := range paths {
+		paths[i].Version = channelVersion
 	}
+	return paths, nil
+}
 
-	session.StopSpinner()
-	_ = session.Println(color.Green.Sprintf(
-		"Channel '%s (%s) <-> %s (%s)' created",
-		chainAID,
-		channel.ASide.ChannelID,
-		chainBID,
-		channel.BSide.ChannelID,
-	))
+// specChainAccounts returns the relayer account setup of each chain from the relayer spec.
+func specChainAccounts(spec *hermes.Spec) []chainAccount {
+	accounts := make([]chainAccount, 0, len(spec.Chains))
+	for _, chain := range spec.Chains {
+		accounts = append(accounts, chainAccount{
+			chainID:        chain.ID,
+			faucet:         chain.Faucet,
+			mnemonic:       chain.Key.Mnemonic,
+			mnemonicFile:   chain.Key.MnemonicFile,
+			account:        chain.Key.Account,
+			keyringBackend: chain.Key.Backend(),
+			keyringDir:     chain.Key.KeyringDir,
+			faucetHint:     fmt.Sprintf("the faucet field of the chain %s in the relayer spec", chain.ID),
+		})
+	}
+	return accounts
+}
 
-	return nil
+// flagChainAccounts returns the relayer account setup of each chain from the faucet and account flags.
+func flagChainAccounts(flags plugin.Flags, chainIDs []string) []chainAccount {
+	var (
+		keyringBackend, _ = flags.GetString(flagKeyringBackend)
+		keyringDir, _     = flags.GetString(flagKeyringDir)
+		accounts          = make([]chainAccount, 0, len(chainIDs))
+	)
+	for i, chainID := range chainIDs {
+		var (
+			faucetFlag = chainFlagsByIndex(i).faucet
+			faucet, _  = flags.GetString(faucetFlag)
+			account, _ = flags.GetString(chainFlagsByIndex(i).account)
+			faucetHint = fmt.Sprintf("the --%s flag", faucetFlag)
+		)
+		// the chain B flags can't set the faucet of several chains.
+		if i > 0 && len(chainIDs) > 2 {
+			faucetHint = fmt.Sprintf("the chain faucet field of a relayer spec file with --%s", flagFrom)
+		}
+		accounts = append(accounts, chainAccount{
+			chainID:        chainID,
+			faucet:         faucet,
+			account:        account,
+			keyringBackend: keyringBackend,
+			keyringDir:     keyringDir,
+			faucetHint:     faucetHint,
+		})
+	}
+	return accounts
 }
 
 // ensureAccount ensures the account exists and get found if the faucet is set.
@@ -258,32 +283,32 @@ func ensureAccount(
 	session *cliui.Session,
 	hCfg *hermes.Config,
 	h *hermes.Hermes,
-	chainID,
-	faucetAddr,
+	account chainAccount,
 	cfgPath string,
 	generateWallets bool,
 ) error {
-	chainAddr, err := verifyChainKeys(ctx, session, h, chainID, cfgPath, generateWallets)
+	faucetAddr := account.faucet
+	chain, err := hCfg.Chains.Get(account.chainID)
 	if err != nil {
 		return err
 	}
-
-	session.StartSpinner(fmt.Sprintf("verifying %s balance", chainAddr))
-
-	chain, err := hCfg.Chains.Get(chainID)
+	chainAddr, err := verifyChainKeys(ctx, session, h, chain, account, cfgPath, generateWallets)
 	if err != nil {
 		return err
 	}
+
+	session.StartSpinner(fmt.Sprintf("verifying %s balance", chainAddr))
+
 	balance, err := chain.Balance(ctx, chainAddr)
 	if err != nil {
 		return err
 	}
 	if balance.Empty() && faucetAddr == "" {
 		return errors.Errorf(
-			"wallet %s balance is empty, please add funds or provide the faucet address flag (--%s or --%s)",
+			"wallet %s balance is empty, please add funds or provide the faucet address with %s",
 			chainAddr,
-			flagChainAFaucet,
-			flagChainBFaucet)
+			account.faucetHint,
+		)
 	}
 	if faucetAddr != "" {
 		session.StartSpinner(fmt.Sprintf("requesting faucet balance for %s", chainAddr))
@@ -302,16 +327,18 @@ func ensureAccount(
 	return nil
 }
 
-// verifyChainKeys verifies if the Hermes has a key for the specific chain,
-// if not,  ask for the user to create one.
+// verifyChainKeys verifies if the Hermes has a key for the specific chain, if not,
+// import the account key, use the account mnemonic or ask for the user to create one.
 func verifyChainKeys(
 	ctx context.Context,
 	session *cliui.Session,
 	h *hermes.Hermes,
-	chainID,
+	chain hermes.Chain,
+	account chainAccount,
 	cfgPath string,
 	generateWallets bool,
 ) (string, error) {
+	chainID := account.chainID
 GetKey:
 	var (
 		bufKeysChain    = bytes.Buffer{}
@@ -329,9 +356,23 @@ GetKey:
 	if err := hermes.UnmarshalResult(bufKeysChain.Bytes(), &keysChainResult); err != nil {
 		return "", err
 	}
+	if keysChainResult.Wallet.Account == "" && account.account != "" {
+		keyPair, err := importAccountKey(chain, account.account, account.keyringBackend, account.keyringDir)
+		if err != nil {
+			return "", err
+		}
+
+		session.StopSpinner()
+		_ = session.Println(color.Green.Sprintf("Chain %s key imported from account %s (%s)", chainID, account.account, keyPair.Account))
+
+		goto GetKey
+	}
 	if keysChainResult.Wallet.Account == "" {
-		var mnemonic string
-		if !generateWallets {
+		mnemonic, err := accountMnemonic(account)
+		if err != nil {
+			return "", err
+		}
+		if mnemonic == "" && !generateWallets {
 			session.StopSpinner()
 			if err := session.Ask(bubbleconfirm.NewQuestion(
 				fmt.Sprintf(
@@ -397,9 +438,49 @@ GetKey:
 	return keysChainResult.Wallet.Account, nil
 }
 
+// accountMnemonic returns the account mnemonic from the mnemonic or the mnemonic file, if any.
+func accountMnemonic(account chainAccount) (string, error) {
+	if account.mnemonicFile == "" {
+		return account.mnemonic, nil
+	}
+	mnemonic, err := os.ReadFile(account.mnemonicFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read chain %s mnemonic file", account.chainID)
+	}
+	return strings.TrimSpace(string(mnemonic)), nil
+}
+
 // newHermesConfig create a new hermes config based in the cmd args.
-func newHermesConfig(flags plugin.Flags, args []string) (*hermes.Config, error) {
+func newHermesConfig(
+	ctx context.Context,
+	session *cliui.Session,
+	flags plugin.Flags,
+	explicit map[string]bool,
+	chains []chainArgs,
+) (*hermes.Config, error) {
 	// Create the default hermes config
+	c := hermes.DefaultConfig(newConfigOptions(flags)...)
+
+	// Add the chains into the config
+	for i, chain := range chains {
+		metadata, err := chainMetadata(ctx, session, flags, chainFlagsByIndex(i), chain.id, chain.rpcAddr)
+		if err != nil {
+			return nil, err
+		}
+		options, err := newChainOptions(flags, explicit, chainFlagsByIndex(i), metadata)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.AddChain(chain.id, chain.rpcAddr, chain.grpcAddr, options...); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// newConfigOptions creates the hermes config options based in the cmd flags.
+func newConfigOptions(flags plugin.Flags) []hermes.ConfigOption {
 	var (
 		telemetryEnabled, _                         = flags.GetBool(flagTelemetryEnabled)
 		telemetryHost, _                            = flags.GetString(flagTelemetryHost)
@@ -419,7 +500,7 @@ func newHermesConfig(flags plugin.Flags, args []string) (*hermes.Config, error)
 		modePacketsAutoRegisterCounterpartyPayee, _ = flags.GetBool(flagAutoRegisterCounterpartyPayee)
 	)
 
-	c := hermes.DefaultConfig(
+	return []hermes.ConfigOption{
 		hermes.WithTelemetryEnabled(telemetryEnabled),
 		hermes.WithTelemetryHost(telemetryHost),
 		hermes.WithTelemetryPort(telemetryPort),
@@ -436,225 +517,177 @@ func newHermesConfig(flags plugin.Flags, args []string) (*hermes.Config, error)
 		hermes.WithModePacketsClearOnStart(modePacketsClearOnStart),
 		hermes.WithModePacketsTxConfirmation(modePacketsTxConfirmation),
 		hermes.WithAutoRegisterCounterpartyPayee(modePacketsAutoRegisterCounterpartyPayee),
-	)
+	}
+}
 
-	// Add chain A into the config
+// newChainOptions creates the hermes chain options based in the chain flags. The chain
+// metadata settings replace the default values of the flags not set explicitly.
+func newChainOptions(
+	flags plugin.Flags,
+	explicit map[string]bool,
+	f chainFlags,
+	metadata hermes.ChainMetadata,
+) ([]hermes.ChainOption, error) {
 	var (
-		chainAID       = args[0]
-		chainARPCAddr  = args[1]
-		chainAGRPCAddr = args[2]
-
-		chainAEventSourceMode, _       = flags.GetString(flagChainAEventSourceMode)
-		chainAEventSourceURL, _        = flags.GetString(flagChainAEventSourceURL)
-		chainAEventSourceBatchDelay, _ = flags.GetString(flagChainAEventSourceBatchDelay)
-		chainARPCTimeout, _            = flags.GetString(flagChainARPCTimeout)
-		chainAAccountPrefix, _         = flags.GetString(flagChainAAccountPrefix)
-		chainAAddressType, _           = flags.GetString(flagChainAAddressType)
-		chainAKeyName, _               = flags.GetString(flagChainAKeyName)
-		chainAKeyStoreType, _          = flags.GetString(flagChainAKeyStoreType)
-		chainAStorePrefix, _           = flags.GetString(flagChainAStorePrefix)
-		chainADefaultGas, _            = flags.GetUint64(flagChainADefaultGas)
-		chainAMaxGas, _                = flags.GetUint64(flagChainAMaxGas)
-		chainAGasPrice, _              = flags.GetString(flagChainAGasPrice)
-		chainAGasMultiplier, _         = flags.GetString(flagChainAGasMultiplier)
-		chainAMaxMsgNum, _             = flags.GetUint64(flagChainAMaxMsgNum)
-		chainAMaxTxSize, _             = flags.GetUint64(flagChainAMaxTxSize)
-		chainAClockDrift, _            = flags.GetString(flagChainAClockDrift)
-		chainAMaxBlockTime, _          = flags.GetString(flagChainAMaxBlockTime)
-		chainATrustingPeriod, _        = flags.GetString(flagChainATrustingPeriod)
-		chainATrustThreshold, _        = flags.GetString(flagChainATrustThreshold)
-		chainACCVConsumerChain, _      = flags.GetBool(flagChainACCVConsumerChain)
-		chainATrustedNode, _           = flags.GetBool(flagChainATrustedNode)
-		chainAMemoPrefix, _            = flags.GetString(flagChainAMemoPrefix)
-		chainAType, _                  = flags.GetString(flagChainAType)
-		chainASequentialBatchTx, _     = flags.GetBool(flagChainASequentialBatchTx)
+		eventSourceMode, _       = flags.GetString(f.eventSourceMode)
+		eventSourceURL, _        = flags.GetString(f.eventSourceURL)
+		eventSourceBatchDelay, _ = flags.GetString(f.eventSourceBatchDelay)
+		rpcTimeout, _            = flags.GetString(f.rpcTimeout)
+		accountPrefix, _         = flags.GetString(f.accountPrefix)
+		addressType, _           = flags.GetString(f.addressType)
+		keyName, _               = flags.GetString(f.keyName)
+		keyStoreType, _          = flags.GetString(f.keyStoreType)
+		storePrefix, _           = flags.GetString(f.storePrefix)
+		defaultGas, _            = flags.GetUint64(f.defaultGas)
+		maxGas, _                = flags.GetUint64(f.maxGas)
+		gasPrice, _              = flags.GetString(f.gasPrice)
+		gasMultiplier, _         = flags.GetString(f.gasMultiplier)
+		maxMsgNum, _             = flags.GetUint64(f.maxMsgNum)
+		maxTxSize, _             = flags.GetUint64(f.maxTxSize)
+		clockDrift, _            = flags.GetString(f.clockDrift)
+		maxBlockTime, _          = flags.GetString(f.maxBlockTime)
+		trustingPeriod, _        = flags.GetString(f.trustingPeriod)
+		trustThreshold, _        = flags.GetString(f.trustThreshold)
+		ccvConsumerChain, _      = flags.GetBool(f.ccvConsumerChain)
+		trustedNode, _           = flags.GetBool(f.trustedNode)
+		memoPrefix, _            = flags.GetString(f.memoPrefix)
+		chainType, _             = flags.GetString(f.chainType)
+		sequentialBatchTx, _     = flags.GetBool(f.sequentialBatchTx)
 	)
 
-	chainAGasMulti := new(big.Float)
-	chainAGasMulti, ok := chainAGasMulti.SetString(chainAGasMultiplier)
+	gasMulti := new(big.Float)
+	gasMulti, ok := gasMulti.SetString(gasMultiplier)
 	if !ok {
-		return nil, errors.Errorf("invalid chain A gas multiplier: %s", chainAGasMultiplier)
+		return nil, errors.Errorf("invalid chain %s gas multiplier: %s", f.name, gasMultiplier)
 	}
 
-	optChainA := []hermes.ChainOption{
-		hermes.WithChainTrustThreshold(chainATrustThreshold),
-		hermes.WithChainGasMultiplier(chainAGasMulti),
-		hermes.WithChainCCVConsumerChain(chainACCVConsumerChain),
-		hermes.WithChainTrustedNode(chainATrustedNode),
-		hermes.WithChainSequentialBatchTx(chainASequentialBatchTx),
+	options := []hermes.ChainOption{
+		hermes.WithChainTrustThreshold(trustThreshold),
+		hermes.WithChainGasMultiplier(gasMulti),
+		hermes.WithChainCCVConsumerChain(ccvConsumerChain),
+		hermes.WithChainTrustedNode(trustedNode),
+		hermes.WithChainSequentialBatchTx(sequentialBatchTx),
 	}
-	if chainAEventSourceURL != "" {
-		optChainA = append(optChainA, hermes.WithChainEventSource(
-			chainAEventSourceMode,
-			chainAEventSourceURL,
-			chainAEventSourceBatchDelay,
+	if eventSourceURL != "" {
+		options = append(options, hermes.WithChainEventSource(
+			eventSourceMode,
+			eventSourceURL,
+			eventSourceBatchDelay,
 		))
 	}
-	if chainARPCTimeout != "" {
-		optChainA = append(optChainA, hermes.WithChainRPCTimeout(chainARPCTimeout))
+	if rpcTimeout != "" {
+		options = append(options, hermes.WithChainRPCTimeout(rpcTimeout))
 	}
-	if chainAAccountPrefix != "" {
-		optChainA = append(optChainA, hermes.WithChainAccountPrefix(chainAAccountPrefix))
+	if accountPrefix != "" {
+		options = append(options, hermes.WithChainAccountPrefix(accountPrefix))
 	}
-	if chainAAddressType != "" {
-		optChainA = append(optChainA, hermes.WithChainAddressType(chainAAddressType))
+	if addressType != "" {
+		options = append(options, hermes.WithChainAddressType(addressType))
 	}
-	if chainAKeyName != "" {
-		optChainA = append(optChainA, hermes.WithChainKeyName(chainAKeyName))
+	if keyName != "" {
+		options = append(options, hermes.WithChainKeyName(keyName))
 	}
-	if chainAKeyStoreType != "" {
-		optChainA = append(optChainA, hermes.WithChainKeyStoreType(chainAKeyStoreType))
+	if keyStoreType != "" {
+		options = append(options, hermes.WithChainKeyStoreType(keyStoreType))
 	}
-	if chainAStorePrefix != "" {
-		optChainA = append(optChainA, hermes.WithChainStorePrefix(chainAStorePrefix))
+	if storePrefix != "" {
+		options = append(options, hermes.WithChainStorePrefix(storePrefix))
 	}
-	if chainADefaultGas > 0 {
-		optChainA = append(optChainA, hermes.WithChainDefaultGas(chainADefaultGas))
+	if defaultGas > 0 {
+		options = append(options, hermes.WithChainDefaultGas(defaultGas))
 	}
-	if chainAMaxGas > 0 {
-		optChainA = append(optChainA, hermes.WithChainMaxGas(chainAMaxGas))
+	if maxGas > 0 {
+		options = append(options, hermes.WithChainMaxGas(maxGas))
 	}
-	if chainAGasPrice != "" {
-		gasPrice, err := sdk.ParseDecCoin(chainAGasPrice)
+	if gasPrice != "" {
+		price, err := sdk.ParseDecCoin(gasPrice)
 		if err != nil {
 			return nil, err
 		}
-		optChainA = append(optChainA, hermes.WithChainGasPrice(gasPrice))
+		options = append(options, hermes.WithChainGasPrice(price))
 	}
-	if chainAMaxMsgNum > 0 {
-		optChainA = append(optChainA, hermes.WithChainMaxMsgNum(chainAMaxMsgNum))
+	if maxMsgNum > 0 {
+		options = append(options, hermes.WithChainMaxMsgNum(maxMsgNum))
 	}
-	if chainAMaxTxSize > 0 {
-		optChainA = append(optChainA, hermes.WithChainMaxTxSize(chainAMaxTxSize))
+	if maxTxSize > 0 {
+		options = append(options, hermes.WithChainMaxTxSize(maxTxSize))
 	}
-	if chainAClockDrift != "" {
-		optChainA = append(optChainA, hermes.WithChainClockDrift(chainAClockDrift))
+	if clockDrift != "" {
+		options = append(options, hermes.WithChainClockDrift(clockDrift))
 	}
-	if chainAMaxBlockTime != "" {
-		optChainA = append(optChainA, hermes.WithChainMaxBlockTime(chainAMaxBlockTime))
+	if maxBlockTime != "" {
+		options = append(options, hermes.WithChainMaxBlockTime(maxBlockTime))
 	}
-	if chainATrustingPeriod != "" {
-		optChainA = append(optChainA, hermes.WithChainTrustingPeriod(chainATrustingPeriod))
+	if trustingPeriod != "" {
+		options = append(options, hermes.WithChainTrustingPeriod(trustingPeriod))
 	}
-	if chainAMemoPrefix != "" {
-		optChainA = append(optChainA, hermes.WithChainMemoPrefix(chainAMemoPrefix))
+	if memoPrefix != "" {
+		options = append(options, hermes.WithChainMemoPrefix(memoPrefix))
 	}
-	if chainAType != "" {
-		optChainA = append(optChainA, hermes.WithChainType(chainAType))
+	if chainType != "" {
+		options = append(options, hermes.WithChainType(chainType))
 	}
 
-	if _, err := c.AddChain(chainAID, chainARPCAddr, chainAGRPCAddr, optChainA...); err != nil {
-		return nil, err
-	}
-
-	// Add chain B into the config
-	var (
-		chainBID       = args[3]
-		chainBRPCAddr  = args[4]
-		chainBGRPCAddr = args[5]
-
-		chainBEventSourceMode, _       = flags.GetString(flagChainBEventSourceMode)
-		chainBEventSourceURL, _        = flags.GetString(flagChainBEventSourceURL)
-		chainBEventSourceBatchDelay, _ = flags.GetString(flagChainBEventSourceBatchDelay)
-		chainBRPCTimeout, _            = flags.GetString(flagChainBRPCTimeout)
-		chainBAccountPrefix, _         = flags.GetString(flagChainBAccountPrefix)
-		chainBAddressType, _           = flags.GetString(flagChainBAddressType)
-		chainBKeyName, _               = flags.GetString(flagChainBKeyName)
-		chainBKeyStoreType, _          = flags.GetString(flagChainBKeyStoreType)
-		chainBStorePrefix, _           = flags.GetString(flagChainBStorePrefix)
-		chainBDefaultGas, _            = flags.GetUint64(flagChainBDefaultGas)
-		chainBMaxGas, _                = flags.GetUint64(flagChainBMaxGas)
-		chainBGasPrice, _              = flags.GetString(flagChainBGasPrice)
-		chainBGasMultiplier, _         = flags.GetString(flagChainBGasMultiplier)
-		chainBMaxMsgNum, _             = flags.GetUint64(flagChainBMaxMsgNum)
-		chainBMaxTxSize, _             = flags.GetUint64(flagChainBMaxTxSize)
-		chainBClockDrift, _            = flags.GetString(flagChainBClockDrift)
-		chainBMaxBlockTime, _          = flags.GetString(flagChainBMaxBlockTime)
-		chainBTrustingPeriod, _        = flags.GetString(flagChainBTrustingPeriod)
-		chainBTrustThreshold, _        = flags.GetString(flagChainBTrustThreshold)
-		chainBCCVConsumerChain, _      = flags.GetBool(flagChainBCCVConsumerChain)
-		chainBTrustedNode, _           = flags.GetBool(flagChainBTrustedNode)
-		chainBMemoPrefix, _            = flags.GetString(flagChainBMemoPrefix)
-		chainBType, _                  = flags.GetString(flagChainBType)
-		chainBSequentialBatchTx, _     = flags.GetBool(flagChainBSequentialBatchTx)
-	)
-
-	chainBGasMulti := new(big.Float)
-	chainBGasMulti, ok = chainBGasMulti.SetString(chainBGasMultiplier)
-	if !ok {
-		return nil, errors.Errorf("invalid chain B gas multiplier: %s", chainBGasMultiplier)
-	}
-
-	optChainB := []hermes.ChainOption{
-		hermes.WithChainTrustThreshold(chainBTrustThreshold),
-		hermes.WithChainGasMultiplier(chainBGasMulti),
-		hermes.WithChainCCVConsumerChain(chainBCCVConsumerChain),
-		hermes.WithChainTrustedNode(chainBTrustedNode),
-		hermes.WithChainSequentialBatchTx(chainBSequentialBatchTx),
-	}
-	if chainBEventSourceURL != "" {
-		optChainB = append(optChainB, hermes.WithChainEventSource(
-			chainBEventSourceMode,
-			chainBEventSourceURL,
-			chainBEventSourceBatchDelay,
+	if metadata.EventSourceURL != "" && !explicit[f.eventSourceURL] {
+		options = append(options, hermes.WithChainEventSource(
+			eventSourceMode,
+			metadata.EventSourceURL,
+			eventSourceBatchDelay,
 		))
 	}
-	if chainBRPCTimeout != "" {
-		optChainB = append(optChainB, hermes.WithChainRPCTimeout(chainBRPCTimeout))
-	}
-	if chainBAccountPrefix != "" {
-		optChainB = append(optChainB, hermes.WithChainAccountPrefix(chainBAccountPrefix))
-	}
-	if chainBAddressType != "" {
-		optChainB = append(optChainB, hermes.WithChainAddressType(chainBAddressType))
+	if metadata.AccountPrefix != "" && !explicit[f.accountPrefix] {
+		options = append(options, hermes.WithChainAccountPrefix(metadata.AccountPrefix))
 	}
-	if chainBKeyName != "" {
-		optChainB = append(optChainB, hermes.WithChainKeyName(chainBKeyName))
+	if metadata.GasPrice.Denom != "" && !explicit[f.gasPrice] {
+		options = append(options, hermes.WithChainGasPrice(metadata.GasPrice))
 	}
-	if chainBKeyStoreType != "" {
-		optChainB = append(optChainB, hermes.WithChainKeyStoreType(chainBKeyStoreType))
+	if metadata.TrustingPeriod != "" && !explicit[f.trustingPeriod] {
+		options = append(options, hermes.WithChainTrustingPeriod(metadata.TrustingPeriod))
 	}
-	if chainBStorePrefix != "" {
-		optChainB = append(optChainB, hermes.WithChainStorePrefix(chainBStorePrefix))
-	}
-	if chainBDefaultGas > 0 {
-		optChainB = append(optChainB, hermes.WithChainDefaultGas(chainBDefaultGas))
-	}
-	if chainBMaxGas > 0 {
-		optChainB = append(optChainB, hermes.WithChainMaxGas(chainBMaxGas))
-	}
-	if chainBGasPrice != "" {
-		gasPrice, err := sdk.ParseDecCoin(chainBGasPrice)
+
+	return options, nil
+}
+
+// chainMetadata returns the chain settings from its chain registry entry and its Ignite config,
+// if set, and from the chain queries, unless skipped. The chain registry entry settings take
+// precedence over the Ignite config ones, and failed chain queries fall back to the flag values.
+func chainMetadata(
+	ctx context.Context,
+	session *cliui.Session,
+	flags plugin.Flags,
+	f chainFlags,
+	chainID, rpcAddr string,
+) (hermes.ChainMetadata, error) {
+	var (
+		registry, _       = flags.GetString(f.registry)
+		igniteConfig, _   = flags.GetString(f.igniteConfig)
+		skipChainQuery, _ = flags.GetBool(flagSkipChainQuery)
+		metadata          hermes.ChainMetadata
+		err               error
+	)
+	if registry != "" {
+		session.StartSpinner(fmt.Sprintf("Loading chain %s registry entry", chainID))
+		metadata, err = hermes.LoadChainRegistry(ctx, chainID, registry)
 		if err != nil {
-			return nil, err
+			return hermes.ChainMetadata{}, err
 		}
-		optChainB = append(optChainB, hermes.WithChainGasPrice(gasPrice))
 	}
-	if chainBMaxMsgNum > 0 {
-		optChainB = append(optChainB, hermes.WithChainMaxMsgNum(chainBMaxMsgNum))
-	}
-	if chainBMaxTxSize > 0 {
-		optChainB = append(optChainB, hermes.WithChainMaxTxSize(chainBMaxTxSize))
-	}
-	if chainBClockDrift != "" {
-		optChainB = append(optChainB, hermes.WithChainClockDrift(chainBClockDrift))
-	}
-	if chainBMaxBlockTime != "" {
-		optChainB = append(optChainB, hermes.WithChainMaxBlockTime(chainBMaxBlockTime))
-	}
-	if chainBTrustingPeriod != "" {
-		optChainB = append(optChainB, hermes.WithChainTrustingPeriod(chainBTrustingPeriod))
-	}
-	if chainBMemoPrefix != "" {
-		optChainB = append(optChainB, hermes.WithChainMemoPrefix(chainBMemoPrefix))
+	if igniteConfig != "" {
+		igniteMetadata, err := hermes.LoadIgniteConfig(chainID, igniteConfig)
+		if err != nil {
+			return hermes.ChainMetadata{}, err
+		}
+		metadata = metadata.Merge(igniteMetadata)
 	}
-	if chainBType != "" {
-		optChainB = append(optChainB, hermes.WithChainType(chainBType))
+	if skipChainQuery {
+		return metadata, nil
 	}
 
-	if _, err := c.AddChain(chainBID, chainBRPCAddr, chainBGRPCAddr, optChainB...); err != nil {
-		return nil, err
+	session.StartSpinner(fmt.Sprintf("Querying chain %s settings", chainID))
+	queried, err := hermes.QueryChainMetadata(ctx, rpcAddr)
+	if err != nil {
+		session.StopSpinner()
+		_ = session.Println(color.Yellow.Sprintf("Chain %s settings partially derived, using the flag values: %s", chainID, err))
 	}
-
-	return c, nil
+	return metadata.Merge(queried), nil
 }
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/hermes/pkg/hermes"
+)
+
+func StopHandler(_ context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		flags   = plugin.Flags(cmd.Flags)
+		args    = cmd.Args
+		session = cliui.New()
+	)
+	defer session.End()
+
+	daemon, err := getDaemon(flags, args)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Stopping hermes relayer %s", daemon.Name()))
+	if err := daemon.Stop(); err != nil {
+		return errors.Wrapf(err, "failed to stop relayer %s", daemon.Name())
+	}
+	session.StopSpinner()
+
+	return session.Println(color.Green.Sprintf("Hermes relayer %s stopped", daemon.Name()))
+}
+
+func RestartHandler(_ context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		flags      = plugin.Flags(cmd.Flags)
+		args       = cmd.Args
+		restart, _ = flags.GetBool(flagRestart)
+		session    = cliui.New()
+	)
+	defer session.End()
+
+	hermesVersion, err := getVersion(flags)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Fetching hermes config")
+	cfgPath, err := getConfigPath(flags, args)
+	if err != nil {
+		return err
+	}
+	daemon, err := hermes.NewDaemon(cfgPath)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Stopping hermes relayer %s", daemon.Name()))
+	if err := daemon.Stop(); err != nil && !errors.Is(err, hermes.ErrNotRunning) {
+		return errors.Wrapf(err, "failed to stop relayer %s", daemon.Name())
+	}
+	session.StopSpinner()
+
+	return startDetached(session, h, cfgPath, restart)
+}
+
+func LogsHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		flags     = plugin.Flags(cmd.Flags)
+		args      = cmd.Args
+		tail, _   = flags.GetUint64(flagTail)
+		follow, _ = flags.GetBool(flagFollow)
+	)
+
+	daemon, err := getDaemon(flags, args)
+	if err != nil {
+		return err
+	}
+
+	lines, err := daemon.Logs(int(tail))
+	if os.IsNotExist(err) {
+		return errors.Errorf("no logs found for relayer %s, start it with the --%s flag", daemon.Name(), flagDetach)
+	} else if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(os.Stdout, line); err != nil {
+			return err
+		}
+	}
+
+	if !follow {
+		return nil
+	}
+	return daemon.FollowLogs(ctx, os.Stdout)
+}
+
+// getDaemon returns the background relayer for the config file.
+func getDaemon(flags plugin.Flags, chainIDs []string) (hermes.Daemon, error) {
+	cfgPath, err := getConfigPath(flags, chainIDs)
+	if err != nil {
+		return hermes.Daemon{}, err
+	}
+	return hermes.NewDaemon(cfgPath)
+}
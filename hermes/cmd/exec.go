@@ -25,7 +25,7 @@ func ExecuteHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	defer session.End()
 
 	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
-	h, err := hermes.New(hermesVersion)
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
 	if err != nil {
 		return err
 	}
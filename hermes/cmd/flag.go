@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"os"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -32,6 +33,9 @@ const (
 	flagChainATrustingPeriod        = "chain-a-trusting-period"
 	flagChainATrustThreshold        = "chain-a-trust-threshold"
 	flagChainAFaucet                = "chain-a-faucet"
+	flagChainAAccount               = "chain-a-account"
+	flagChainARegistry              = "chain-a-registry"
+	flagChainAIgniteConfig          = "chain-a-ignite-config"
 	flagChainACCVConsumerChain      = "chain-a-ccv-consumer-chain"
 	flagChainATrustedNode           = "chain-a-trusted-node"
 	flagChainAMemoPrefix            = "chain-a-memo-prefix"
@@ -59,6 +63,9 @@ const (
 	flagChainBTrustingPeriod        = "chain-b-trusting-period"
 	flagChainBTrustThreshold        = "chain-b-trust-threshold"
 	flagChainBFaucet                = "chain-b-faucet"
+	flagChainBAccount               = "chain-b-account"
+	flagChainBRegistry              = "chain-b-registry"
+	flagChainBIgniteConfig          = "chain-b-ignite-config"
 	flagChainBCCVConsumerChain      = "chain-b-ccv-consumer-chain"
 	flagChainBTrustedNode           = "chain-b-trusted-node"
 	flagChainBMemoPrefix            = "chain-b-memo-prefix"
@@ -84,18 +91,279 @@ const (
 	flagGenerateWallets               = "generate-wallets"
 	flagOverwriteConfig               = "overwrite-config"
 	flagChannelVersion                = "channel-version"
+	flagPath                          = "path"
+	flagFrom                          = "from"
+	flagForceNew                      = "force-new"
+	flagJSON                          = "json"
+	flagPort                          = "port"
+	flagChannel                       = "channel"
+	flagAmount                        = "amount"
+	flagDenom                         = "denom"
+	flagTimeout                       = "timeout"
+	flagPacketTimeout                 = "packet-timeout"
+	flagDetach                        = "detach"
+	flagRestart                       = "restart"
+	flagTail                          = "tail"
+	flagFollow                        = "follow"
+	flagConfigChains                  = "config-chains"
+	flagKeyringBackend                = "keyring-backend"
+	flagKeyringDir                    = "keyring-dir"
+	flagSkipChainQuery                = "skip-chain-query"
 
 	flagConfig        = "config"
 	flagHermesVersion = "hermes-version"
+	flagHermesBinary  = "hermes-binary"
+	flagHermesMirror  = "hermes-mirror"
 
 	mnemonicEntropySize = 256
 )
 
+// chainFlags represents the flag names configuring a chain.
+type chainFlags struct {
+	name                  string
+	portID                string
+	eventSourceMode       string
+	eventSourceURL        string
+	eventSourceBatchDelay string
+	rpcTimeout            string
+	accountPrefix         string
+	addressType           string
+	keyName               string
+	keyStoreType          string
+	storePrefix           string
+	defaultGas            string
+	maxGas                string
+	gasPrice              string
+	gasMultiplier         string
+	maxMsgNum             string
+	maxTxSize             string
+	clockDrift            string
+	maxBlockTime          string
+	trustingPeriod        string
+	trustThreshold        string
+	faucet                string
+	account               string
+	registry              string
+	igniteConfig          string
+	ccvConsumerChain      string
+	trustedNode           string
+	memoPrefix            string
+	chainType             string
+	sequentialBatchTx     string
+}
+
+var (
+	chainAFlags = chainFlags{
+		name:                  "A",
+		portID:                flagChainAPortID,
+		eventSourceMode:       flagChainAEventSourceMode,
+		eventSourceURL:        flagChainAEventSourceURL,
+		eventSourceBatchDelay: flagChainAEventSourceBatchDelay,
+		rpcTimeout:            flagChainARPCTimeout,
+		accountPrefix:         flagChainAAccountPrefix,
+		addressType:           flagChainAAddressType,
+		keyName:               flagChainAKeyName,
+		keyStoreType:          flagChainAKeyStoreType,
+		storePrefix:           flagChainAStorePrefix,
+		defaultGas:            flagChainADefaultGas,
+		maxGas:                flagChainAMaxGas,
+		gasPrice:              flagChainAGasPrice,
+		gasMultiplier:         flagChainAGasMultiplier,
+		maxMsgNum:             flagChainAMaxMsgNum,
+		maxTxSize:             flagChainAMaxTxSize,
+		clockDrift:            flagChainAClockDrift,
+		maxBlockTime:          flagChainAMaxBlockTime,
+		trustingPeriod:        flagChainATrustingPeriod,
+		trustThreshold:        flagChainATrustThreshold,
+		faucet:                flagChainAFaucet,
+		account:               flagChainAAccount,
+		registry:              flagChainARegistry,
+		igniteConfig:          flagChainAIgniteConfig,
+		ccvConsumerChain:      flagChainACCVConsumerChain,
+		trustedNode:           flagChainATrustedNode,
+		memoPrefix:            flagChainAMemoPrefix,
+		chainType:             flagChainAType,
+		sequentialBatchTx:     flagChainASequentialBatchTx,
+	}
+	chainBFlags = chainFlags{
+		name:                  "B",
+		portID:                flagChainBPortID,
+		eventSourceMode:       flagChainBEventSourceMode,
+		eventSourceURL:        flagChainBEventSourceURL,
+		eventSourceBatchDelay: flagChainBEventSourceBatchDelay,
+		rpcTimeout:            flagChainBRPCTimeout,
+		accountPrefix:         flagChainBAccountPrefix,
+		addressType:           flagChainBAddressType,
+		keyName:               flagChainBKeyName,
+		keyStoreType:          flagChainBKeyStoreType,
+		storePrefix:           flagChainBStorePrefix,
+		defaultGas:            flagChainBDefaultGas,
+		maxGas:                flagChainBMaxGas,
+		gasPrice:              flagChainBGasPrice,
+		gasMultiplier:         flagChainBGasMultiplier,
+		maxMsgNum:             flagChainBMaxMsgNum,
+		maxTxSize:             flagChainBMaxTxSize,
+		clockDrift:            flagChainBClockDrift,
+		maxBlockTime:          flagChainBMaxBlockTime,
+		trustingPeriod:        flagChainBTrustingPeriod,
+		trustThreshold:        flagChainBTrustThreshold,
+		faucet:                flagChainBFaucet,
+		account:               flagChainBAccount,
+		registry:              flagChainBRegistry,
+		igniteConfig:          flagChainBIgniteConfig,
+		ccvConsumerChain:      flagChainBCCVConsumerChain,
+		trustedNode:           flagChainBTrustedNode,
+		memoPrefix:            flagChainBMemoPrefix,
+		chainType:             flagChainBType,
+		sequentialBatchTx:     flagChainBSequentialBatchTx,
+	}
+)
+
+// chainFlagsByIndex returns the flags configuring the chain from the index of the
+// chain args. The chain A flags configure the first (hub) chain and the chain B
+// flags configure all the other chains.
+func chainFlagsByIndex(i int) chainFlags {
+	if i == 0 {
+		return chainAFlags
+	}
+	return chainBFlags
+}
+
+// perChainFlags returns the chain flags whose values can't be shared by several chains,
+// such as their endpoints, keys and fees.
+func (f chainFlags) perChainFlags() []string {
+	return []string{
+		f.eventSourceURL,
+		f.accountPrefix,
+		f.keyName,
+		f.gasPrice,
+		f.faucet,
+		f.account,
+		f.registry,
+		f.igniteConfig,
+	}
+}
+
+// validateChainFlags checks the chain B flags set explicitly configure a single chain.
+// With more than two chains, the chain B flags configure all the other chains, so the
+// per-chain settings must be declared into a relayer spec file.
+func validateChainFlags(explicit map[string]bool, chainCount int) error {
+	if chainCount <= 2 {
+		return nil
+	}
+	for _, name := range chainBFlags.perChainFlags() {
+		if explicit[name] {
+			return errors.Errorf(
+				"the --%s flag can't configure the %d chains after the first one, use a relayer spec file (--%s) to set per-chain settings",
+				name,
+				chainCount-1,
+				flagFrom,
+			)
+		}
+	}
+	return nil
+}
+
+// explicitFlags returns the names of the flags set in the command line, even when set
+// to their default value. The args after the "--" terminator are not parsed as flags.
+func explicitFlags(cmd *plugin.ExecutedCommand) map[string]bool {
+	shorthands := make(map[string]string)
+	for _, flag := range cmd.Flags {
+		if flag.Shorthand != "" {
+			shorthands[flag.Shorthand] = flag.Name
+		}
+	}
+
+	explicit := make(map[string]bool)
+	for _, arg := range cmd.OsArgs {
+		switch {
+		case arg == "--":
+			return explicit
+		case strings.HasPrefix(arg, "--"):
+			name, _, _ := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+			explicit[name] = true
+		case strings.HasPrefix(arg, "-") && len(arg) > 1:
+			// combined shorthands (e.g. -df) are all set, up to a value attached to the last one (e.g. -n10)
+			for _, shorthand := range strings.TrimPrefix(arg, "-") {
+				name, ok := shorthands[string(shorthand)]
+				if !ok {
+					break
+				}
+				explicit[name] = true
+			}
+		}
+	}
+	return explicit
+}
+
 func getConfig(flags plugin.Flags) string {
 	config, _ := flags.GetString(flagConfig)
 	return config
 }
 
+// getConfigPath returns the custom config file path, if set, or the config
+// file path from the chain ids, in the same order as the configure command.
+func getConfigPath(flags plugin.Flags, chainIDs []string) (string, error) {
+	cfgPath := getConfig(flags)
+	if cfgPath == "" {
+		if len(chainIDs) < 2 {
+			return "", errors.New("at least two chain ids must be provided, in the same order as the configure command")
+		}
+		var err error
+		cfgPath, err = hermes.ConfigFilePath(strings.Join(chainIDs, hermes.ConfigNameSeparator))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := os.Stat(cfgPath); os.IsNotExist(err) {
+		return "", errors.Errorf("config file (%s) not exist, try to configure you relayer first", cfgPath)
+	}
+	return cfgPath, nil
+}
+
+// findConfigPath returns the custom config file path, if set, or the only
+// config file path relaying all the chains.
+func findConfigPath(flags plugin.Flags, chainIDs ...string) (string, error) {
+	if cfgPath := getConfig(flags); cfgPath != "" {
+		return cfgPath, nil
+	}
+
+	cfgPaths, err := hermes.FindConfigs(chainIDs...)
+	if err != nil {
+		return "", err
+	}
+	switch len(cfgPaths) {
+	case 0:
+		return "", errors.Errorf("no config file found for chains %s, try to configure you relayer first", strings.Join(chainIDs, ", "))
+	case 1:
+		return cfgPaths[0], nil
+	default:
+		return "", errors.Errorf(
+			"multiple config files found for chains %s, use the --%s flag to choose one: %s",
+			strings.Join(chainIDs, ", "),
+			flagConfig,
+			strings.Join(cfgPaths, ", "),
+		)
+	}
+}
+
+// getBinOptions returns the options to resolve the Hermes binary.
+func getBinOptions(flags plugin.Flags) []hermes.BinOption {
+	var (
+		binary, _    = flags.GetString(flagHermesBinary)
+		mirrorURL, _ = flags.GetString(flagHermesMirror)
+		options      = make([]hermes.BinOption, 0)
+	)
+	if binary != "" {
+		options = append(options, hermes.WithBinary(binary))
+	}
+	if mirrorURL != "" {
+		options = append(options, hermes.WithMirrorURL(mirrorURL))
+	}
+	return options
+}
+
 func getVersion(flags plugin.Flags) (string, error) {
 	version, _ := flags.GetString(flagHermesVersion)
 	if version == "" {
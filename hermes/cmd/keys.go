@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 
+	"github.com/gookit/color"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/services/plugin"
 
 	"github.com/ignite/apps/hermes/pkg/hermes"
@@ -25,7 +29,7 @@ func KeysAddMnemonicHandler(ctx context.Context, cmd *plugin.ExecutedCommand) er
 	}
 
 	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
-	h, err := hermes.New(hermesVersion)
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
 	if err != nil {
 		return err
 	}
@@ -55,7 +59,7 @@ func KeysAddFileHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error
 	}
 
 	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
-	h, err := hermes.New(hermesVersion)
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
 	if err != nil {
 		return err
 	}
@@ -71,6 +75,41 @@ func KeysAddFileHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error
 	)
 }
 
+func KeysImportHandler(_ context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args              = cmd.Args
+		flags             = plugin.Flags(cmd.Flags)
+		keyringBackend, _ = flags.GetString(flagKeyringBackend)
+		keyringDir, _     = flags.GetString(flagKeyringDir)
+		session           = cliui.New()
+	)
+	defer session.End()
+
+	chainID, accountName := args[0], args[1]
+	cfgPath, err := findConfigPath(flags, chainID)
+	if err != nil {
+		return err
+	}
+	cfg, err := hermes.LoadConfig(cfgPath)
+	if err != nil {
+		return err
+	}
+	chain, err := cfg.Chains.Get(chainID)
+	if err != nil {
+		return err
+	}
+
+	keyPair, err := importAccountKey(chain, accountName, keyringBackend, keyringDir)
+	if err != nil {
+		return err
+	}
+	return session.Printf(
+		"%s %s\n",
+		color.Green.Sprintf("Chain %s key %s imported from account %s:", chainID, chain.KeyName, accountName),
+		color.Yellow.Sprint(keyPair.Account),
+	)
+}
+
 func KeysListHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	var (
 		args    = cmd.Args
@@ -85,7 +124,7 @@ func KeysListHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	}
 
 	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
-	h, err := hermes.New(hermesVersion)
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
 	if err != nil {
 		return err
 	}
@@ -113,7 +152,7 @@ func KeysDeleteHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 	}
 
 	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
-	h, err := hermes.New(hermesVersion)
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
 	if err != nil {
 		return err
 	}
@@ -129,3 +168,28 @@ func KeysDeleteHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
 		hermes.WithStdErr(os.Stderr),
 	)
 }
+
+// importAccountKey imports the key of an Ignite account, or of a key from a chain keyring
+// when the keyring dir is set, into the Hermes keyring as the chain key.
+func importAccountKey(chain hermes.Chain, name, keyringBackend, keyringDir string) (hermes.KeyPair, error) {
+	options := []cosmosaccount.Option{
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringBackend(keyringBackend)),
+	}
+	if keyringDir != "" {
+		options = append(options, cosmosaccount.WithHome(keyringDir))
+	}
+	registry, err := cosmosaccount.New(options...)
+	if err != nil {
+		return hermes.KeyPair{}, err
+	}
+
+	privKeyHex, err := registry.ExportHex(name, "")
+	if err != nil {
+		return hermes.KeyPair{}, errors.Wrapf(err, "failed to export account %s", name)
+	}
+	privKey, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return hermes.KeyPair{}, errors.Wrapf(err, "invalid account %s private key", name)
+	}
+	return chain.ImportKey(privKey)
+}
@@ -0,0 +1,308 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/hermes/pkg/hermes"
+)
+
+var (
+	pendingPacketsHeader = []string{"Chain", "Unreceived packets", "Unreceived acks"}
+	packetEventsHeader   = []string{"Event", "Sequence", "Source", "Destination", "Height"}
+)
+
+func PacketsClearHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args    = cmd.Args
+		flags   = plugin.Flags(cmd.Flags)
+		session = cliui.New()
+	)
+	defer session.End()
+
+	if len(args) != 3 {
+		return errors.New("expected the chain id, port id and channel id args")
+	}
+	chainID, portID, channelID := args[0], args[1], args[2]
+
+	h, cfgPath, err := packetsHermes(session, flags, chainID)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Clearing packets from %s %s/%s", chainID, portID, channelID))
+	var (
+		buf    = bytes.Buffer{}
+		events = hermes.PacketEvents{}
+	)
+	if err := h.ClearPackets(
+		ctx,
+		chainID,
+		portID,
+		channelID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&buf),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return err
+	}
+	if err := hermes.UnmarshalResult(buf.Bytes(), &events); err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	return printPacketEvents(session, flags, events)
+}
+
+func PacketsPendingHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args    = cmd.Args
+		flags   = plugin.Flags(cmd.Flags)
+		session = cliui.New()
+	)
+	defer session.End()
+
+	if len(args) != 3 {
+		return errors.New("expected the chain id, port id and channel id args")
+	}
+	chainID, portID, channelID := args[0], args[1], args[2]
+
+	h, cfgPath, err := packetsHermes(session, flags, chainID)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Querying pending packets from %s %s/%s", chainID, portID, channelID))
+	var (
+		buf     = bytes.Buffer{}
+		pending = hermes.PendingPacketsResult{}
+	)
+	if err := h.QueryPacketPending(
+		ctx,
+		chainID,
+		portID,
+		channelID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&buf),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return err
+	}
+	if err := hermes.UnmarshalResult(buf.Bytes(), &pending); err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	if jsonOutput, _ := flags.GetBool(flagJSON); jsonOutput {
+		return printJSON(pending)
+	}
+	return session.PrintTable(
+		pendingPacketsHeader,
+		[]string{
+			chainID,
+			formatSequences(pending.Src.UnreceivedPackets),
+			formatSequences(pending.Src.UnreceivedAcks),
+		},
+		[]string{
+			"counterparty",
+			formatSequences(pending.Dst.UnreceivedPackets),
+			formatSequences(pending.Dst.UnreceivedAcks),
+		},
+	)
+}
+
+func PacketsCommitmentsHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	return packetSequencesHandler(ctx, cmd, "commitments", (*hermes.Hermes).QueryPacketCommitments)
+}
+
+func PacketsAcksHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	return packetSequencesHandler(ctx, cmd, "acknowledgments", (*hermes.Hermes).QueryPacketAcks)
+}
+
+func PacketsRecvHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args    = cmd.Args
+		flags   = plugin.Flags(cmd.Flags)
+		session = cliui.New()
+	)
+	defer session.End()
+
+	if len(args) != 4 {
+		return errors.New("expected the destination chain id, source chain id, source port id and source channel id args")
+	}
+	dstChainID, srcChainID, srcPortID, srcChannelID := args[0], args[1], args[2], args[3]
+
+	h, cfgPath, err := packetsHermes(session, flags, dstChainID, srcChainID)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Relaying packets from %s %s/%s to %s", srcChainID, srcPortID, srcChannelID, dstChainID))
+	var (
+		buf    = bytes.Buffer{}
+		events = hermes.PacketEvents{}
+	)
+	if err := h.TxPacketRecv(
+		ctx,
+		dstChainID,
+		srcChainID,
+		srcPortID,
+		srcChannelID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&buf),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return err
+	}
+	if err := hermes.UnmarshalResult(buf.Bytes(), &events); err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	return printPacketEvents(session, flags, events)
+}
+
+// packetSequencesHandler queries and prints the packet sequences of a channel.
+func packetSequencesHandler(
+	ctx context.Context,
+	cmd *plugin.ExecutedCommand,
+	name string,
+	query func(h *hermes.Hermes, ctx context.Context, chain, port, channel string, options ...hermes.Option) error,
+) error {
+	var (
+		args    = cmd.Args
+		flags   = plugin.Flags(cmd.Flags)
+		session = cliui.New()
+	)
+	defer session.End()
+
+	if len(args) != 3 {
+		return errors.New("expected the chain id, port id and channel id args")
+	}
+	chainID, portID, channelID := args[0], args[1], args[2]
+
+	h, cfgPath, err := packetsHermes(session, flags, chainID)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Querying packet %s from %s %s/%s", name, chainID, portID, channelID))
+	var (
+		buf       = bytes.Buffer{}
+		sequences = hermes.PacketSequences{}
+	)
+	if err := query(
+		h,
+		ctx,
+		chainID,
+		portID,
+		channelID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&buf),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return err
+	}
+	if err := hermes.UnmarshalResult(buf.Bytes(), &sequences); err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	if jsonOutput, _ := flags.GetBool(flagJSON); jsonOutput {
+		return printJSON(sequences)
+	}
+	return session.Printf(
+		"%s packet %s at height %d: %s\n",
+		chainID,
+		name,
+		sequences.Height.RevisionHeight,
+		formatSequences(sequences.Seqs),
+	)
+}
+
+// packetsHermes returns the Hermes binary and the config file relaying the chains.
+func packetsHermes(session *cliui.Session, flags plugin.Flags, chainIDs ...string) (*hermes.Hermes, string, error) {
+	hermesVersion, err := getVersion(flags)
+	if err != nil {
+		return nil, "", err
+	}
+
+	session.StartSpinner("Fetching hermes config")
+	cfgPath, err := findConfigPath(flags, chainIDs...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
+	if err != nil {
+		return nil, "", err
+	}
+	return h, cfgPath, nil
+}
+
+// printPacketEvents prints the IBC events emitted while relaying packets. It returns
+// an error with the messages of the chain errors returned while relaying.
+func printPacketEvents(session *cliui.Session, flags plugin.Flags, events hermes.PacketEvents) error {
+	if jsonOutput, _ := flags.GetBool(flagJSON); jsonOutput {
+		if err := printJSON(events); err != nil {
+			return err
+		}
+		return events.Err()
+	}
+	if len(events) == 0 {
+		return session.Println(color.Yellow.Sprint("No packets to relay"))
+	}
+
+	entries := make([][]string, 0, len(events))
+	for _, event := range events {
+		if event.Kind == hermes.EventChainError {
+			continue
+		}
+		entries = append(entries, []string{
+			event.Kind,
+			strconv.FormatUint(event.Packet.Sequence, 10),
+			fmt.Sprintf("%s/%s", event.Packet.SourcePort, event.Packet.SourceChannel),
+			fmt.Sprintf("%s/%s", event.Packet.DestinationPort, event.Packet.DestinationChannel),
+			strconv.Itoa(event.Height.RevisionHeight),
+		})
+	}
+	if len(entries) > 0 {
+		if err := session.PrintTable(packetEventsHeader, entries...); err != nil {
+			return err
+		}
+	}
+	return events.Err()
+}
+
+// printJSON prints the value encoded as JSON to the standard output.
+func printJSON(v any) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(out))
+	return err
+}
+
+// formatSequences formats the packet sequences as a comma separated list.
+func formatSequences(seqs []uint64) string {
+	if len(seqs) == 0 {
+		return "-"
+	}
+	values := make([]string, 0, len(seqs))
+	for _, seq := range seqs {
+		values = append(values, strconv.FormatUint(seq, 10))
+	}
+	return strings.Join(values, ", ")
+}
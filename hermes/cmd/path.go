@@ -0,0 +1,470 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+
+	"github.com/ignite/apps/hermes/pkg/hermes"
+)
+
+// createPath creates the clients, the connection and the channel relaying the path chains.
+// Open channels and connections and active clients are reused, unless forceNew is set.
+func createPath(
+	ctx context.Context,
+	session *cliui.Session,
+	h *hermes.Hermes,
+	cfgPath string,
+	path hermes.Path,
+	forceNew bool,
+) error {
+	var connectionA string
+	if !forceNew {
+		// reuse the channel if the path is already relayed
+		session.StartSpinner(fmt.Sprintf("Looking for an open channel %s", path))
+		channelID, channelEnd, found, err := findOpenChannel(ctx, h, cfgPath, path)
+		if err != nil {
+			return err
+		}
+		if found {
+			session.StopSpinner()
+			_ = session.Println(color.Green.Sprintf(
+				"Channel '%s (%s) <-> %s (%s)' already exist, reusing it",
+				path.ChainA,
+				channelID,
+				path.ChainB,
+				channelEnd.Remote.ChannelID,
+			))
+			return nil
+		}
+
+		// reuse the connection if the chains are already connected
+		session.StartSpinner(fmt.Sprintf("Looking for an open connection %s <-> %s", path.ChainA, path.ChainB))
+		connectionID, connectionEnd, found, err := findOpenConnection(ctx, h, cfgPath, path)
+		if err != nil {
+			return err
+		}
+		if found {
+			connectionA = connectionID
+			session.StopSpinner()
+			_ = session.Println(color.Green.Sprintf(
+				"Connection '%s (%s) <-> %s (%s)' already exist, reusing it",
+				path.ChainA,
+				connectionID,
+				path.ChainB,
+				connectionEnd.Counterparty.ConnectionID,
+			))
+		}
+	}
+
+	if connectionA == "" {
+		clientA, err := ensureClient(ctx, session, h, cfgPath, path.ChainA, path.ChainB, forceNew)
+		if err != nil {
+			return err
+		}
+		clientB, err := ensureClient(ctx, session, h, cfgPath, path.ChainB, path.ChainA, forceNew)
+		if err != nil {
+			return err
+		}
+		connectionA, err = createConnection(ctx, session, h, cfgPath, path, clientA, clientB)
+		if err != nil {
+			return err
+		}
+	}
+
+	return createChannel(ctx, session, h, cfgPath, path, connectionA)
+}
+
+// ensureClient returns an active client hosted by the host chain and tracking the
+// reference chain. A new client is created if there is none or if forceNew is set.
+func ensureClient(
+	ctx context.Context,
+	session *cliui.Session,
+	h *hermes.Hermes,
+	cfgPath,
+	hostChain,
+	referenceChain string,
+	forceNew bool,
+) (string, error) {
+	if !forceNew {
+		session.StartSpinner(fmt.Sprintf("Looking for an active client %s -> %s", hostChain, referenceChain))
+		clientID, found, err := findActiveClient(ctx, h, cfgPath, hostChain, referenceChain)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			session.StopSpinner()
+			_ = session.Println(color.Green.Sprintf(
+				"Client '%s' already exist (%s -> %s), reusing it",
+				clientID,
+				hostChain,
+				referenceChain,
+			))
+			return clientID, nil
+		}
+	}
+
+	session.StartSpinner(fmt.Sprintf("Creating client %s -> %s", hostChain, referenceChain))
+	var (
+		bufClientResult = bytes.Buffer{}
+		clientResult    = hermes.ClientResult{}
+	)
+	if err := h.CreateClient(
+		ctx,
+		hostChain,
+		referenceChain,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufClientResult),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return "", err
+	}
+	if err := hermes.UnmarshalResult(bufClientResult.Bytes(), &clientResult); err != nil {
+		return "", err
+	}
+
+	session.StopSpinner()
+	_ = session.Println(color.Green.Sprintf(
+		"Client '%s' created (%s -> %s)",
+		clientResult.CreateClient.ClientID,
+		hostChain,
+		referenceChain,
+	))
+	return clientResult.CreateClient.ClientID, nil
+}
+
+// createConnection creates a new connection between the path chains and returns the chain A connection id.
+func createConnection(
+	ctx context.Context,
+	session *cliui.Session,
+	h *hermes.Hermes,
+	cfgPath string,
+	path hermes.Path,
+	clientA,
+	clientB string,
+) (string, error) {
+	session.StartSpinner(fmt.Sprintf("Creating connection %s <-> %s", path.ChainA, path.ChainB))
+	var (
+		bufConnection  = bytes.Buffer{}
+		connection     = hermes.ConnectionResult{}
+		createConnOpts = []hermes.Option{
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufConnection),
+			hermes.WithJSONOutput(),
+		}
+	)
+	if path.ConnectionDelay > 0 {
+		createConnOpts = append(createConnOpts, hermes.WithFlags(hermes.Flags{
+			hermes.FlagDelay: uint64(path.ConnectionDelay.Seconds()),
+		}))
+	}
+
+	if err := h.CreateConnection(
+		ctx,
+		path.ChainA,
+		clientA,
+		clientB,
+		createConnOpts...,
+	); err != nil {
+		return "", err
+	}
+	if err := hermes.UnmarshalResult(bufConnection.Bytes(), &connection); err != nil {
+		return "", err
+	}
+
+	session.StopSpinner()
+	_ = session.Println(color.Green.Sprintf(
+		"Connection '%s (%s) <-> %s (%s)' created",
+		path.ChainA,
+		connection.ASide.ConnectionID,
+		path.ChainB,
+		connection.BSide.ConnectionID,
+	))
+	return connection.ASide.ConnectionID, nil
+}
+
+// createChannel creates a new channel relaying the path ports over the chain A connection.
+func createChannel(
+	ctx context.Context,
+	session *cliui.Session,
+	h *hermes.Hermes,
+	cfgPath string,
+	path hermes.Path,
+	connectionA string,
+) error {
+	session.StartSpinner(fmt.Sprintf("Creating channel %s", path))
+	var (
+		bufChannel     = bytes.Buffer{}
+		channel        = hermes.ConnectionResult{}
+		createChanOpts = []hermes.Option{
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufChannel),
+			hermes.WithJSONOutput(),
+		}
+	)
+	if path.Version != "" {
+		createChanOpts = append(createChanOpts, hermes.WithFlags(hermes.Flags{hermes.FlagChannelVersion: path.Version}))
+	}
+	if path.Ordering != "" {
+		createChanOpts = append(createChanOpts, hermes.WithFlags(hermes.Flags{hermes.FlagOrder: path.Ordering}))
+	}
+
+	if err := h.CreateChannel(
+		ctx,
+		path.ChainA,
+		connectionA,
+		path.PortA,
+		path.PortB,
+		createChanOpts...,
+	); err != nil {
+		return err
+	}
+	if err := hermes.UnmarshalResult(bufChannel.Bytes(), &channel); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	_ = session.Println(color.Green.Sprintf(
+		"Channel '%s (%s) <-> %s (%s)' created",
+		path.ChainA,
+		channel.ASide.ChannelID,
+		path.ChainB,
+		channel.BSide.ChannelID,
+	))
+	return nil
+}
+
+// findOpenChannel looks for an open channel relaying the path from the chain A,
+// over an open connection with active clients on both sides.
+func findOpenChannel(
+	ctx context.Context,
+	h *hermes.Hermes,
+	cfgPath string,
+	path hermes.Path,
+) (string, hermes.ChannelEnd, bool, error) {
+	var (
+		bufChannels = bytes.Buffer{}
+		channels    = make([]hermes.PortChannelID, 0)
+	)
+	if err := h.QueryChannels(
+		ctx,
+		false,
+		path.ChainA,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufChannels),
+		hermes.WithJSONOutput(),
+		hermes.WithFlags(hermes.Flags{hermes.FlagCounterparty: path.ChainB}),
+	); err != nil {
+		return "", hermes.ChannelEnd{}, false, err
+	}
+	if err := hermes.UnmarshalResult(bufChannels.Bytes(), &channels); err != nil {
+		return "", hermes.ChannelEnd{}, false, err
+	}
+
+	for _, channel := range channels {
+		if channel.PortID != path.PortA {
+			continue
+		}
+
+		var (
+			bufChannelEnd = bytes.Buffer{}
+			channelEnd    = hermes.ChannelEnd{}
+		)
+		if err := h.QueryChannelEnd(
+			ctx,
+			path.ChainA,
+			channel.PortID,
+			channel.ChannelID,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufChannelEnd),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return "", hermes.ChannelEnd{}, false, err
+		}
+		if err := hermes.UnmarshalResult(bufChannelEnd.Bytes(), &channelEnd); err != nil {
+			return "", hermes.ChannelEnd{}, false, err
+		}
+		if !channelEnd.IsOpen() || !channelEnd.Matches(path) || len(channelEnd.ConnectionHops) == 0 {
+			continue
+		}
+
+		// a channel over a connection with an expired or frozen client can't relay packets anymore.
+		active, err := isConnectionActive(ctx, h, cfgPath, path, channelEnd.ConnectionHops[0])
+		if err != nil {
+			return "", hermes.ChannelEnd{}, false, err
+		}
+		if active {
+			return channel.ChannelID, channelEnd, true, nil
+		}
+	}
+	return "", hermes.ChannelEnd{}, false, nil
+}
+
+// findOpenConnection looks for an open connection from the path chain A to the chain B,
+// with the path connection delay and active clients on both sides.
+func findOpenConnection(
+	ctx context.Context,
+	h *hermes.Hermes,
+	cfgPath string,
+	path hermes.Path,
+) (string, hermes.ConnectionEnd, bool, error) {
+	var (
+		bufConnections = bytes.Buffer{}
+		connections    = make([]string, 0)
+	)
+	if err := h.QueryConnections(
+		ctx,
+		path.ChainA,
+		path.ChainB,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufConnections),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return "", hermes.ConnectionEnd{}, false, err
+	}
+	if err := hermes.UnmarshalResult(bufConnections.Bytes(), &connections); err != nil {
+		return "", hermes.ConnectionEnd{}, false, err
+	}
+
+	for _, connectionID := range connections {
+		var (
+			bufConnectionEnd = bytes.Buffer{}
+			connectionEnd    = hermes.ConnectionEnd{}
+		)
+		if err := h.QueryConnectionEnd(
+			ctx,
+			path.ChainA,
+			connectionID,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufConnectionEnd),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return "", hermes.ConnectionEnd{}, false, err
+		}
+		if err := hermes.UnmarshalResult(bufConnectionEnd.Bytes(), &connectionEnd); err != nil {
+			return "", hermes.ConnectionEnd{}, false, err
+		}
+		if !connectionEnd.IsOpen() || connectionEnd.DelayPeriod.Delay() != path.ConnectionDelay.Truncate(time.Second) {
+			continue
+		}
+
+		active, err := areClientsActive(ctx, h, cfgPath, path, connectionEnd)
+		if err != nil {
+			return "", hermes.ConnectionEnd{}, false, err
+		}
+		if active {
+			return connectionID, connectionEnd, true, nil
+		}
+	}
+	return "", hermes.ConnectionEnd{}, false, nil
+}
+
+// isConnectionActive returns true if the chain A connection is open with active clients on both sides.
+func isConnectionActive(
+	ctx context.Context,
+	h *hermes.Hermes,
+	cfgPath string,
+	path hermes.Path,
+	connectionID string,
+) (bool, error) {
+	var (
+		bufConnectionEnd = bytes.Buffer{}
+		connectionEnd    = hermes.ConnectionEnd{}
+	)
+	if err := h.QueryConnectionEnd(
+		ctx,
+		path.ChainA,
+		connectionID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufConnectionEnd),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return false, err
+	}
+	if err := hermes.UnmarshalResult(bufConnectionEnd.Bytes(), &connectionEnd); err != nil {
+		return false, err
+	}
+	if !connectionEnd.IsOpen() {
+		return false, nil
+	}
+	return areClientsActive(ctx, h, cfgPath, path, connectionEnd)
+}
+
+// areClientsActive returns true if the connection clients of both path chains are active.
+func areClientsActive(
+	ctx context.Context,
+	h *hermes.Hermes,
+	cfgPath string,
+	path hermes.Path,
+	connectionEnd hermes.ConnectionEnd,
+) (bool, error) {
+	active, err := isClientActive(ctx, h, cfgPath, path.ChainA, connectionEnd.ClientID)
+	if err != nil || !active {
+		return false, err
+	}
+	return isClientActive(ctx, h, cfgPath, path.ChainB, connectionEnd.Counterparty.ClientID)
+}
+
+// findActiveClient looks for an active client hosted by the host chain and tracking the reference chain.
+func findActiveClient(
+	ctx context.Context,
+	h *hermes.Hermes,
+	cfgPath,
+	hostChain,
+	referenceChain string,
+) (string, bool, error) {
+	var (
+		bufClients = bytes.Buffer{}
+		clients    = make([]hermes.ClientChain, 0)
+	)
+	if err := h.QueryClients(
+		ctx,
+		hostChain,
+		referenceChain,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufClients),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return "", false, err
+	}
+	if err := hermes.UnmarshalResult(bufClients.Bytes(), &clients); err != nil {
+		return "", false, err
+	}
+
+	for _, client := range clients {
+		active, err := isClientActive(ctx, h, cfgPath, hostChain, client.ClientID)
+		if err != nil {
+			return "", false, err
+		}
+		if active {
+			return client.ClientID, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// isClientActive returns true if the client is neither expired nor frozen.
+func isClientActive(ctx context.Context, h *hermes.Hermes, cfgPath, chainID, clientID string) (bool, error) {
+	var (
+		bufStatus = bytes.Buffer{}
+		status    string
+	)
+	if err := h.QueryClientStatus(
+		ctx,
+		chainID,
+		clientID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufStatus),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return false, err
+	}
+	if err := hermes.UnmarshalResult(bufStatus.Bytes(), &status); err != nil {
+		return false, err
+	}
+	return status == hermes.ClientStatusActive, nil
+}
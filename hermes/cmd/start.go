@@ -4,10 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 
+	"github.com/gookit/color"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
-	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/services/plugin"
 
 	"github.com/ignite/apps/hermes/pkg/hermes"
@@ -15,11 +14,11 @@ import (
 
 func StartHandler(ctx context.Context, cmd *plugin.ExecutedCommand) (err error) {
 	var (
-		flags     = cmd.Flags
-		args      = cmd.Args
-		customCfg = getConfig(flags)
-		cfgName   = strings.Join(args, hermes.ConfigNameSeparator)
-		session   = cliui.New()
+		flags      = plugin.Flags(cmd.Flags)
+		args       = cmd.Args
+		detach, _  = flags.GetBool(flagDetach)
+		restart, _ = flags.GetBool(flagRestart)
+		session    = cliui.New()
 	)
 	defer session.End()
 
@@ -29,25 +28,25 @@ func StartHandler(ctx context.Context, cmd *plugin.ExecutedCommand) (err error)
 	}
 
 	session.StartSpinner("Fetching hermes config")
-	cfgPath := customCfg
-	if cfgPath == "" {
-		cfgPath, err = hermes.ConfigFilePath(cfgName)
-		if err != nil {
-			return err
-		}
-	}
-
-	if _, err := os.Stat(cfgPath); os.IsNotExist(err) {
-		return errors.Errorf("config file (%s) not exist, try to configure you relayer first", cfgPath)
+	cfgPath, err := getConfigPath(flags, args)
+	if err != nil {
+		return err
 	}
 
 	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
-	h, err := hermes.New(hermesVersion)
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
 	if err != nil {
 		return err
 	}
 	session.StopSpinner()
 
+	if detach {
+		return startDetached(session, h, cfgPath, restart)
+	}
+	if restart {
+		return h.Supervise(ctx, cfgPath, os.Stdout, true)
+	}
+
 	return h.Start(
 		ctx,
 		hermes.WithConfigFile(cfgPath),
@@ -56,3 +55,21 @@ func StartHandler(ctx context.Context, cmd *plugin.ExecutedCommand) (err error)
 		hermes.WithStdErr(os.Stderr),
 	)
 }
+
+// startDetached starts the relayer in background for the config file.
+func startDetached(session *cliui.Session, h *hermes.Hermes, cfgPath string, restart bool) error {
+	daemon, err := hermes.NewDaemon(cfgPath)
+	if err != nil {
+		return err
+	}
+	pid, err := daemon.Start(h, cfgPath, restart)
+	if err != nil {
+		return err
+	}
+	return session.Println(color.Green.Sprintf(
+		"Hermes relayer %s started in background (pid %d), logs: %s",
+		daemon.Name(),
+		pid,
+		daemon.LogFile(),
+	))
+}
@@ -0,0 +1,452 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/hermes/pkg/hermes"
+)
+
+var (
+	chainStatusHeader      = []string{"Chain", "Relayer wallet", "Balances"}
+	clientStatusHeader     = []string{"Chain", "Client", "Counterparty", "Status", "Expires at", "Headroom"}
+	connectionStatusHeader = []string{"Chain", "Connection", "Client", "Counterparty", "Counterparty connection", "State"}
+	channelStatusHeader    = []string{"Chain", "Channel", "Counterparty", "Counterparty channel", "State", "Pending packets"}
+)
+
+func StatusHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		flags         = plugin.Flags(cmd.Flags)
+		args          = cmd.Args
+		jsonOutput, _ = flags.GetBool(flagJSON)
+		session       = cliui.New()
+	)
+	defer session.End()
+
+	hermesVersion, err := getVersion(flags)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Fetching hermes config")
+	cfgPath, err := getConfigPath(flags, args)
+	if err != nil {
+		return err
+	}
+	hermesCfg, err := hermes.LoadConfig(cfgPath)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Fetching hermes binary %s", hermesVersion))
+	h, err := hermes.New(hermesVersion, getBinOptions(flags)...)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Querying relayer status")
+	report := relayerStatus(ctx, h, hermesCfg, cfgPath)
+	session.StopSpinner()
+
+	if jsonOutput {
+		out, err := report.JSON()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(os.Stdout, string(out))
+		return err
+	}
+	return printStatusReport(session, report)
+}
+
+// relayerStatus queries the status of all the chains from the Hermes config.
+// Query errors are added to the report instead of interrupting the status.
+func relayerStatus(ctx context.Context, h *hermes.Hermes, cfg *hermes.Config, cfgPath string) hermes.StatusReport {
+	report := hermes.StatusReport{
+		Config:      cfgPath,
+		Chains:      make([]hermes.ChainStatus, 0),
+		Clients:     make([]hermes.ClientStatus, 0),
+		Connections: make([]hermes.ConnectionStatus, 0),
+		Channels:    make([]hermes.ChannelStatus, 0),
+	}
+	addErr := func(err error) {
+		report.Errors = append(report.Errors, err.Error())
+	}
+
+	for _, chain := range cfg.Chains {
+		chainStatus, err := walletStatus(ctx, h, chain, cfgPath)
+		if err != nil {
+			addErr(err)
+		}
+		report.Chains = append(report.Chains, chainStatus)
+	}
+
+	for i, chain := range cfg.Chains {
+		for j, counterparty := range cfg.Chains {
+			if i == j {
+				continue
+			}
+
+			clients, err := clientsStatus(ctx, h, cfgPath, chain.ID, counterparty.ID)
+			if err != nil {
+				addErr(err)
+			}
+			report.Clients = append(report.Clients, clients...)
+
+			// connections and channels are listed once for each pair of chains
+			if j < i {
+				continue
+			}
+
+			connections, err := connectionsStatus(ctx, h, cfgPath, chain.ID, counterparty.ID)
+			if err != nil {
+				addErr(err)
+			}
+			report.Connections = append(report.Connections, connections...)
+
+			channels, err := channelsStatus(ctx, h, cfgPath, chain.ID, counterparty.ID)
+			if err != nil {
+				addErr(err)
+			}
+			report.Channels = append(report.Channels, channels...)
+		}
+	}
+	return report
+}
+
+// walletStatus returns the relayer wallet and its balances on the chain.
+func walletStatus(ctx context.Context, h *hermes.Hermes, chain hermes.Chain, cfgPath string) (hermes.ChainStatus, error) {
+	status := hermes.ChainStatus{ChainID: chain.ID}
+
+	var (
+		bufKeys    = bytes.Buffer{}
+		keysResult = hermes.KeysListResult{}
+	)
+	if err := h.KeysList(
+		ctx,
+		chain.ID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufKeys),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return status, err
+	}
+	if err := hermes.UnmarshalResult(bufKeys.Bytes(), &keysResult); err != nil {
+		return status, err
+	}
+	status.Wallet = keysResult.Wallet.Account
+	if status.Wallet == "" {
+		return status, nil
+	}
+
+	balances, err := chain.Balance(ctx, status.Wallet)
+	if err != nil {
+		return status, err
+	}
+	status.Balances = balances.String()
+	return status, nil
+}
+
+// clientsStatus returns the status of the clients hosted by the chain and tracking the counterparty chain.
+func clientsStatus(ctx context.Context, h *hermes.Hermes, cfgPath, chainID, counterpartyID string) ([]hermes.ClientStatus, error) {
+	var (
+		bufClients = bytes.Buffer{}
+		clients    = make([]hermes.ClientChain, 0)
+	)
+	if err := h.QueryClients(
+		ctx,
+		chainID,
+		counterpartyID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufClients),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return nil, err
+	}
+	if err := hermes.UnmarshalResult(bufClients.Bytes(), &clients); err != nil {
+		return nil, err
+	}
+
+	status := make([]hermes.ClientStatus, 0, len(clients))
+	for _, client := range clients {
+		var (
+			bufStatus      = bytes.Buffer{}
+			bufState       = bytes.Buffer{}
+			bufConsensus   = bytes.Buffer{}
+			clientStatus   string
+			clientState    = hermes.ClientState{}
+			consensusState = hermes.ConsensusState{}
+		)
+		if err := h.QueryClientStatus(
+			ctx,
+			chainID,
+			client.ClientID,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufStatus),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return status, err
+		}
+		if err := hermes.UnmarshalResult(bufStatus.Bytes(), &clientStatus); err != nil {
+			return status, err
+		}
+
+		if err := h.QueryClientState(
+			ctx,
+			chainID,
+			client.ClientID,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufState),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return status, err
+		}
+		if err := hermes.UnmarshalResult(bufState.Bytes(), &clientState); err != nil {
+			return status, err
+		}
+
+		// the consensus state of the latest height holds the last client update time
+		if err := h.QueryClientConsensus(
+			ctx,
+			chainID,
+			client.ClientID,
+			clientState.LatestHeight.RevisionHeight,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufConsensus),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return status, err
+		}
+		if err := hermes.UnmarshalResult(bufConsensus.Bytes(), &consensusState); err != nil {
+			return status, err
+		}
+
+		status = append(status, hermes.NewClientStatus(
+			chainID,
+			client.ClientID,
+			counterpartyID,
+			clientStatus,
+			clientState.TrustingPeriod.Delay(),
+			consensusState.Timestamp,
+			time.Now(),
+		))
+	}
+	return status, nil
+}
+
+// connectionsStatus returns the status of the connections between the chain and the counterparty chain.
+func connectionsStatus(ctx context.Context, h *hermes.Hermes, cfgPath, chainID, counterpartyID string) ([]hermes.ConnectionStatus, error) {
+	var (
+		bufConnections = bytes.Buffer{}
+		connections    = make([]string, 0)
+	)
+	if err := h.QueryConnections(
+		ctx,
+		chainID,
+		counterpartyID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufConnections),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return nil, err
+	}
+	if err := hermes.UnmarshalResult(bufConnections.Bytes(), &connections); err != nil {
+		return nil, err
+	}
+
+	status := make([]hermes.ConnectionStatus, 0, len(connections))
+	for _, connectionID := range connections {
+		var (
+			bufConnectionEnd = bytes.Buffer{}
+			connectionEnd    = hermes.ConnectionEnd{}
+		)
+		if err := h.QueryConnectionEnd(
+			ctx,
+			chainID,
+			connectionID,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufConnectionEnd),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return status, err
+		}
+		if err := hermes.UnmarshalResult(bufConnectionEnd.Bytes(), &connectionEnd); err != nil {
+			return status, err
+		}
+
+		status = append(status, hermes.ConnectionStatus{
+			ChainID:                  chainID,
+			ConnectionID:             connectionID,
+			ClientID:                 connectionEnd.ClientID,
+			CounterpartyChainID:      counterpartyID,
+			CounterpartyConnectionID: connectionEnd.Counterparty.ConnectionID,
+			State:                    connectionEnd.State,
+		})
+	}
+	return status, nil
+}
+
+// channelsStatus returns the status and the pending packets of the channels between the chain and the counterparty chain.
+func channelsStatus(ctx context.Context, h *hermes.Hermes, cfgPath, chainID, counterpartyID string) ([]hermes.ChannelStatus, error) {
+	var (
+		bufChannels = bytes.Buffer{}
+		channels    = make([]hermes.PortChannelID, 0)
+	)
+	if err := h.QueryChannels(
+		ctx,
+		false,
+		chainID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&bufChannels),
+		hermes.WithJSONOutput(),
+		hermes.WithFlags(hermes.Flags{hermes.FlagCounterparty: counterpartyID}),
+	); err != nil {
+		return nil, err
+	}
+	if err := hermes.UnmarshalResult(bufChannels.Bytes(), &channels); err != nil {
+		return nil, err
+	}
+
+	status := make([]hermes.ChannelStatus, 0, len(channels))
+	for _, channel := range channels {
+		var (
+			bufChannelEnd = bytes.Buffer{}
+			bufPending    = bytes.Buffer{}
+			channelEnd    = hermes.ChannelEnd{}
+			pending       = hermes.PendingPacketsResult{}
+		)
+		if err := h.QueryChannelEnd(
+			ctx,
+			chainID,
+			channel.PortID,
+			channel.ChannelID,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufChannelEnd),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return status, err
+		}
+		if err := hermes.UnmarshalResult(bufChannelEnd.Bytes(), &channelEnd); err != nil {
+			return status, err
+		}
+
+		// pending packets can only be queried from open channels
+		if channelEnd.IsOpen() {
+			if err := h.QueryPacketPending(
+				ctx,
+				chainID,
+				channel.PortID,
+				channel.ChannelID,
+				hermes.WithConfigFile(cfgPath),
+				hermes.WithStdOut(&bufPending),
+				hermes.WithJSONOutput(),
+			); err != nil {
+				return status, err
+			}
+			if err := hermes.UnmarshalResult(bufPending.Bytes(), &pending); err != nil {
+				return status, err
+			}
+		}
+
+		status = append(status, hermes.ChannelStatus{
+			ChainID:               chainID,
+			PortID:                channel.PortID,
+			ChannelID:             channel.ChannelID,
+			CounterpartyChainID:   counterpartyID,
+			CounterpartyPortID:    channelEnd.Remote.PortID,
+			CounterpartyChannelID: channelEnd.Remote.ChannelID,
+			State:                 channelEnd.State,
+			PendingPackets:        pending,
+		})
+	}
+	return status, nil
+}
+
+// printStatusReport prints the status report tables and the summary.
+func printStatusReport(session *cliui.Session, report hermes.StatusReport) error {
+	chainEntries := make([][]string, 0, len(report.Chains))
+	for _, chain := range report.Chains {
+		chainEntries = append(chainEntries, []string{
+			chain.ChainID,
+			entryOrNone(chain.Wallet),
+			entryOrNone(chain.Balances),
+		})
+	}
+	if err := session.PrintTable(chainStatusHeader, chainEntries...); err != nil {
+		return err
+	}
+	_ = session.Println()
+
+	clientEntries := make([][]string, 0, len(report.Clients))
+	for _, client := range report.Clients {
+		clientEntries = append(clientEntries, []string{
+			client.ChainID,
+			client.ClientID,
+			client.CounterpartyChainID,
+			client.Status,
+			client.ExpiresAt.Format(time.RFC3339),
+			client.Headroom,
+		})
+	}
+	if err := session.PrintTable(clientStatusHeader, clientEntries...); err != nil {
+		return err
+	}
+	_ = session.Println()
+
+	connectionEntries := make([][]string, 0, len(report.Connections))
+	for _, connection := range report.Connections {
+		connectionEntries = append(connectionEntries, []string{
+			connection.ChainID,
+			connection.ConnectionID,
+			connection.ClientID,
+			connection.CounterpartyChainID,
+			connection.CounterpartyConnectionID,
+			connection.State,
+		})
+	}
+	if err := session.PrintTable(connectionStatusHeader, connectionEntries...); err != nil {
+		return err
+	}
+	_ = session.Println()
+
+	channelEntries := make([][]string, 0, len(report.Channels))
+	for _, channel := range report.Channels {
+		channelEntries = append(channelEntries, []string{
+			channel.ChainID,
+			fmt.Sprintf("%s/%s", channel.PortID, channel.ChannelID),
+			channel.CounterpartyChainID,
+			fmt.Sprintf("%s/%s", channel.CounterpartyPortID, channel.CounterpartyChannelID),
+			channel.State,
+			strconv.Itoa(channel.PendingPackets.Total()),
+		})
+	}
+	if err := session.PrintTable(channelStatusHeader, channelEntries...); err != nil {
+		return err
+	}
+	_ = session.Println()
+
+	issues := report.Issues()
+	if len(issues) == 0 {
+		return session.Println(color.Green.Sprint("Relayer is healthy, all clients are active and no packet is pending"))
+	}
+	_ = session.Println(color.Red.Sprintf("Relayer has %d issue(s):", len(issues)))
+	for _, issue := range issues {
+		_ = session.Printf("  - %s\n", issue)
+	}
+	return nil
+}
+
+func entryOrNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
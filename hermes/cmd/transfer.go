@@ -0,0 +1,294 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/hermes/pkg/hermes"
+)
+
+// transferPollInterval is the interval between the counterparty balance queries.
+const transferPollInterval = 2 * time.Second
+
+func TestTransferHandler(ctx context.Context, cmd *plugin.ExecutedCommand) error {
+	var (
+		args          = cmd.Args
+		flags         = plugin.Flags(cmd.Flags)
+		portID, _     = flags.GetString(flagPort)
+		channelID, _  = flags.GetString(flagChannel)
+		amount, _     = flags.GetUint64(flagAmount)
+		denom, _      = flags.GetString(flagDenom)
+		timeout, _    = flags.GetString(flagTimeout)
+		pktTimeout, _ = flags.GetString(flagPacketTimeout)
+		session       = cliui.New()
+	)
+	defer session.End()
+
+	if len(args) != 2 {
+		return errors.New("expected the source and destination chain ids args")
+	}
+	srcChainID, dstChainID := args[0], args[1]
+
+	if amount == 0 {
+		return errors.New("the transfer amount must be greater than zero")
+	}
+	waitTimeout, err := time.ParseDuration(timeout)
+	if err != nil {
+		return errors.Wrapf(err, "invalid transfer timeout %s", timeout)
+	}
+	packetTimeout, err := time.ParseDuration(pktTimeout)
+	if err != nil {
+		return errors.Wrapf(err, "invalid transfer packet timeout %s", pktTimeout)
+	}
+
+	h, cfgPath, err := packetsHermes(session, flags, srcChainID, dstChainID)
+	if err != nil {
+		return err
+	}
+	hermesCfg, err := hermes.LoadConfig(cfgPath)
+	if err != nil {
+		return err
+	}
+	srcChain, err := hermesCfg.Chains.Get(srcChainID)
+	if err != nil {
+		return err
+	}
+	dstChain, err := hermesCfg.Chains.Get(dstChainID)
+	if err != nil {
+		return err
+	}
+	if denom == "" {
+		denom = srcChain.GasPrice.Denom
+	}
+
+	session.StartSpinner(fmt.Sprintf("Finding the %s channel from %s to %s", portID, srcChainID, dstChainID))
+	channelID, channelEnd, err := findTransferChannel(ctx, h, cfgPath, srcChainID, dstChainID, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(fmt.Sprintf("Fetching the %s relayer wallet", dstChainID))
+	receiver, err := relayerWallet(ctx, h, cfgPath, dstChainID)
+	if err != nil {
+		return err
+	}
+
+	ibcDenom := hermes.IBCDenom(channelEnd.Remote.PortID, channelEnd.Remote.ChannelID, denom)
+	balances, err := dstChain.Balance(ctx, receiver)
+	if err != nil {
+		return err
+	}
+	expected := balances.AmountOf(ibcDenom).Add(sdkmath.NewIntFromUint64(amount))
+
+	session.StartSpinner(fmt.Sprintf("Sending %d%s from %s %s/%s", amount, denom, srcChainID, portID, channelID))
+	var (
+		buf    = bytes.Buffer{}
+		events = hermes.PacketEvents{}
+	)
+	if err := h.FtTransfer(
+		ctx,
+		dstChainID,
+		srcChainID,
+		portID,
+		channelID,
+		amount,
+		denom,
+		packetTimeout,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&buf),
+		hermes.WithJSONOutput(),
+		hermes.WithFlags(hermes.Flags{hermes.FlagReceiver: receiver}),
+	); err != nil {
+		return err
+	}
+	if err := hermes.UnmarshalResult(buf.Bytes(), &events); err != nil {
+		return err
+	}
+	sent := time.Now()
+	if err := events.Err(); err != nil {
+		return err
+	}
+
+	sequences := events.Sequences(hermes.EventSendPacket)
+	if len(sequences) == 0 {
+		return errors.Errorf("transfer from %s %s/%s did not send any packet", srcChainID, portID, channelID)
+	}
+	session.StopSpinner()
+	_ = session.Printf(
+		"Sent %d%s from %s %s/%s to %s %s (packet sequence %d)\n",
+		amount,
+		denom,
+		srcChainID,
+		portID,
+		channelID,
+		dstChainID,
+		receiver,
+		sequences[0],
+	)
+
+	session.StartSpinner(fmt.Sprintf("Waiting the relayer to deliver the packet to %s", dstChainID))
+	err = waitBalance(ctx, dstChain, receiver, ibcDenom, expected, waitTimeout)
+	latency := time.Since(sent).Truncate(time.Millisecond)
+	session.StopSpinner()
+	if errors.Is(err, context.DeadlineExceeded) {
+		_ = session.Println(color.Red.Sprintf(
+			"FAIL: %s not received on %s after %s, check the relayer is running",
+			ibcDenom,
+			dstChainID,
+			waitTimeout,
+		))
+		return errors.Errorf("transfer test from %s to %s failed", srcChainID, dstChainID)
+	} else if err != nil {
+		return err
+	}
+
+	return session.Println(color.Green.Sprintf(
+		"PASS: %d%s received on %s %s/%s after %s",
+		amount,
+		ibcDenom,
+		dstChainID,
+		channelEnd.Remote.PortID,
+		channelEnd.Remote.ChannelID,
+		latency,
+	))
+}
+
+// findTransferChannel returns the open channel from the source chain port to the destination chain.
+// If the channel id is set, the channel is checked instead.
+func findTransferChannel(
+	ctx context.Context,
+	h *hermes.Hermes,
+	cfgPath,
+	srcChainID,
+	dstChainID,
+	portID,
+	channelID string,
+) (string, hermes.ChannelEnd, error) {
+	channelIDs := []string{channelID}
+	if channelID == "" {
+		var (
+			bufChannels = bytes.Buffer{}
+			channels    = make([]hermes.PortChannelID, 0)
+		)
+		if err := h.QueryChannels(
+			ctx,
+			false,
+			srcChainID,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufChannels),
+			hermes.WithJSONOutput(),
+			hermes.WithFlags(hermes.Flags{hermes.FlagCounterparty: dstChainID}),
+		); err != nil {
+			return "", hermes.ChannelEnd{}, err
+		}
+		if err := hermes.UnmarshalResult(bufChannels.Bytes(), &channels); err != nil {
+			return "", hermes.ChannelEnd{}, err
+		}
+
+		channelIDs = make([]string, 0, len(channels))
+		for _, channel := range channels {
+			if channel.PortID == portID {
+				channelIDs = append(channelIDs, channel.ChannelID)
+			}
+		}
+	}
+
+	for _, id := range channelIDs {
+		var (
+			bufChannelEnd = bytes.Buffer{}
+			channelEnd    = hermes.ChannelEnd{}
+		)
+		if err := h.QueryChannelEnd(
+			ctx,
+			srcChainID,
+			portID,
+			id,
+			hermes.WithConfigFile(cfgPath),
+			hermes.WithStdOut(&bufChannelEnd),
+			hermes.WithJSONOutput(),
+		); err != nil {
+			return "", hermes.ChannelEnd{}, err
+		}
+		if err := hermes.UnmarshalResult(bufChannelEnd.Bytes(), &channelEnd); err != nil {
+			return "", hermes.ChannelEnd{}, err
+		}
+		if channelEnd.IsOpen() {
+			return id, channelEnd, nil
+		}
+	}
+
+	if channelID != "" {
+		return "", hermes.ChannelEnd{}, errors.Errorf("channel %s/%s on %s is not open", portID, channelID, srcChainID)
+	}
+	return "", hermes.ChannelEnd{}, errors.Errorf(
+		"no open %s channel found from %s to %s, try to configure you relayer first",
+		portID,
+		srcChainID,
+		dstChainID,
+	)
+}
+
+// relayerWallet returns the relayer wallet address on the chain.
+func relayerWallet(ctx context.Context, h *hermes.Hermes, cfgPath, chainID string) (string, error) {
+	var (
+		buf    = bytes.Buffer{}
+		result = hermes.KeysListResult{}
+	)
+	if err := h.KeysList(
+		ctx,
+		chainID,
+		hermes.WithConfigFile(cfgPath),
+		hermes.WithStdOut(&buf),
+		hermes.WithJSONOutput(),
+	); err != nil {
+		return "", err
+	}
+	if err := hermes.UnmarshalResult(buf.Bytes(), &result); err != nil {
+		return "", err
+	}
+	if result.Wallet.Account == "" {
+		return "", errors.Errorf("no relayer key found for chain %s", chainID)
+	}
+	return result.Wallet.Account, nil
+}
+
+// waitBalance waits until the address balance of the denom reaches the expected amount.
+func waitBalance(
+	ctx context.Context,
+	chain hermes.Chain,
+	addr,
+	denom string,
+	expected sdkmath.Int,
+	timeout time.Duration,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(transferPollInterval)
+	defer ticker.Stop()
+	for {
+		balances, err := chain.Balance(ctx, addr)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		} else if err != nil {
+			return err
+		}
+		if balances.AmountOf(denom).GTE(expected) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
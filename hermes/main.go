@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	hplugin "github.com/hashicorp/go-plugin"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/services/plugin"
 
 	"github.com/ignite/apps/hermes/cmd"
+	"github.com/ignite/apps/hermes/pkg/hermes"
 )
 
 type app struct{}
@@ -38,12 +41,54 @@ func (app) Execute(ctx context.Context, c *plugin.ExecutedCommand, _ plugin.Clie
 		return cmd.ExecuteHandler(ctx, c)
 	case "start":
 		return cmd.StartHandler(ctx, c)
+	case "stop":
+		return cmd.StopHandler(ctx, c)
+	case "restart":
+		return cmd.RestartHandler(ctx, c)
+	case "logs":
+		return cmd.LogsHandler(ctx, c)
+	case "status":
+		return cmd.StatusHandler(ctx, c)
+	case "test-transfer":
+		return cmd.TestTransferHandler(ctx, c)
+	case "packets":
+		switch args[1] {
+		case "clear":
+			return cmd.PacketsClearHandler(ctx, c)
+		case "pending":
+			return cmd.PacketsPendingHandler(ctx, c)
+		case "commitments":
+			return cmd.PacketsCommitmentsHandler(ctx, c)
+		case "acks":
+			return cmd.PacketsAcksHandler(ctx, c)
+		case "recv":
+			return cmd.PacketsRecvHandler(ctx, c)
+		default:
+			return errors.Errorf("unknown packets command: %s", args[1])
+		}
+	case "config":
+		switch args[1] {
+		case "list":
+			return cmd.ConfigListHandler(ctx, c)
+		case "show":
+			return cmd.ConfigShowHandler(ctx, c)
+		case "edit":
+			return cmd.ConfigEditHandler(ctx, c)
+		case "remove":
+			return cmd.ConfigRemoveHandler(ctx, c)
+		case "add-chain":
+			return cmd.ConfigAddChainHandler(ctx, c)
+		default:
+			return errors.Errorf("unknown config command: %s", args[1])
+		}
 	case "keys":
 		switch args[1] {
 		case "add":
 			return cmd.KeysAddMnemonicHandler(ctx, c)
 		case "file":
 			return cmd.KeysAddFileHandler(ctx, c)
+		case "import":
+			return cmd.KeysImportHandler(ctx, c)
 		case "list":
 			return cmd.KeysListHandler(ctx, c)
 		case "delete":
@@ -69,6 +114,15 @@ func (app) ExecuteHookCleanUp(context.Context, *plugin.ExecutedHook, plugin.Clie
 }
 
 func main() {
+	// the app binary also runs the relayer supervisor started by "hermes start --detach"
+	if len(os.Args) > 1 && os.Args[1] == hermes.SupervisorCommand {
+		if err := hermes.RunSupervisor(os.Args[2:]); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	hplugin.Serve(&hplugin.ServeConfig{
 		HandshakeConfig: plugin.HandshakeConfig(),
 		Plugins: map[string]hplugin.Plugin{
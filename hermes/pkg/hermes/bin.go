@@ -3,6 +3,8 @@ package hermes
 import (
 	"archive/tar"
 	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,17 +23,46 @@ import (
 const (
 	DefaultVersion = "v1.13.1"
 	apiURL         = "https://api.github.com/repos/informalsystems/hermes/releases/tags/"
+
+	// checksumExt is the extension of the published SHA-256 checksum files.
+	checksumExt = ".sha256"
+	// archiveExt is the extension of the release archives.
+	archiveExt = ".tar.gz"
+	// digestPrefix is the prefix of the SHA-256 digest of the GitHub release assets.
+	digestPrefix = "sha256:"
 )
 
+// ErrChecksumMismatch indicates that a file doesn't match its expected checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
 type asset struct {
 	Name               string `json:"name"`
 	BrowserDownloadURL string `json:"browser_download_url"`
+	Digest             string `json:"digest"`
 }
 
 type release struct {
 	Assets []asset `json:"assets"`
 }
 
+type (
+	// releaseAsset represents a Hermes release archive and where to find its checksum.
+	releaseAsset struct {
+		url         string
+		checksumURL string
+		digest      string
+	}
+
+	// BinOption configures how the Hermes binary is resolved.
+	BinOption func(*binOptions)
+
+	// binOptions holds the Hermes binary options.
+	binOptions struct {
+		binary    string
+		mirrorURL string
+	}
+)
+
 // Maps GOARCH and GOOS to the expected naming used in Hermes release assets.
 var archMap = map[string]string{
 	"amd64": "x86_64",
@@ -48,6 +79,21 @@ var osMap = map[string]string{
 	"linux":  "unknown-linux-gnu",
 }
 
+// WithBinary uses a locally built or mirrored Hermes binary instead of downloading it.
+func WithBinary(path string) BinOption {
+	return func(o *binOptions) {
+		o.binary = path
+	}
+}
+
+// WithMirrorURL downloads the Hermes release archives and checksums from a mirror
+// instead of GitHub. The mirror must serve the files as <mirror-url>/<version>/<asset>.
+func WithMirrorURL(mirrorURL string) BinOption {
+	return func(o *binOptions) {
+		o.mirrorURL = strings.TrimSuffix(mirrorURL, "/")
+	}
+}
+
 // ClearBinCachePath clear all binary files from the cache path.
 func ClearBinCachePath() (string, error) {
 	cfgPath, err := binCacheDirPath()
@@ -79,28 +125,42 @@ func binCachePath(version string) (string, error) {
 }
 
 // hermesBin returns the path to the Hermes binary, downloading and extracting it if necessary.
-func hermesBin(version string) (string, error) {
+// Cached binaries are verified against the checksum saved when they were extracted from a
+// verified release archive, so the network is only used when the cache is missing or corrupted.
+func hermesBin(version, mirrorURL string) (string, error) {
 	binPath, err := binCachePath(version)
 	if err != nil {
 		return "", err
 	}
 	if stat, err := os.Stat(binPath); err == nil && !stat.IsDir() {
-		return binPath, nil // already cached
+		if err := verifyCachedBin(binPath); err == nil {
+			return binPath, nil // already cached
+		}
+		// the cached binary is corrupted or was cached without its checksum, fetch it again.
+		if err := os.Remove(binPath); err != nil {
+			return "", errors.Errorf("failed to remove cached binary: %w", err)
+		}
 	}
-	return fetchBin(version)
-}
 
-// fetchBin downloads and extracts the correct Hermes binary for the current platform.
-func fetchBin(version string) (string, error) {
-	url, err := getHermesAssetURL(version)
+	var relAsset releaseAsset
+	if mirrorURL != "" {
+		relAsset, err = getMirrorAsset(mirrorURL, version)
+	} else {
+		relAsset, err = getHermesAsset(version)
+	}
 	if err != nil {
 		return "", err
 	}
-	return downloadAndExtractHermes(url, version)
+
+	checksum, err := expectedChecksum(relAsset)
+	if err != nil {
+		return "", err
+	}
+	return downloadAndExtractHermes(relAsset.url, version, checksum)
 }
 
-// getHermesAssetURL queries GitHub Releases API and resolves the download URL for the current system.
-func getHermesAssetURL(version string) (string, error) {
+// hermesAssetName returns the Hermes release archive name for the current system.
+func hermesAssetName(version string) (string, error) {
 	osGo := runtime.GOOS
 	archGo := runtime.GOARCH
 
@@ -114,48 +174,169 @@ func getHermesAssetURL(version string) (string, error) {
 		return "", errors.Errorf("unsupported OS: %s", osGo)
 	}
 
-	expectedAssetName := fmt.Sprintf("hermes-%s-%s-%s.tar.gz", version, archMapped, osMapped)
+	return fmt.Sprintf("hermes-%s-%s-%s.tar.gz", version, archMapped, osMapped), nil
+}
+
+// getMirrorAsset resolves the release archive and checksum URLs from a mirror.
+func getMirrorAsset(mirrorURL, version string) (releaseAsset, error) {
+	assetName, err := hermesAssetName(version)
+	if err != nil {
+		return releaseAsset{}, err
+	}
+	assetURL := fmt.Sprintf("%s/%s/%s", mirrorURL, version, assetName)
+	return releaseAsset{url: assetURL, checksumURL: assetURL + checksumExt}, nil
+}
+
+// getHermesAsset queries GitHub Releases API and resolves the download and checksum URLs for the current system.
+func getHermesAsset(version string) (releaseAsset, error) {
+	expectedAssetName, err := hermesAssetName(version)
+	if err != nil {
+		return releaseAsset{}, err
+	}
 
 	// Request release metadata from GitHub
 	resp, err := httpClient.Get(apiURL + version)
 	if err != nil {
-		return "", errors.Errorf("failed to fetch release info: %w", err)
+		return releaseAsset{}, errors.Errorf("failed to fetch release info: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return "", errors.Errorf("Hermes %s not found", version)
+		return releaseAsset{}, errors.Errorf("Hermes %s not found", version)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", errors.Errorf("GitHub API returned status: %d", resp.StatusCode)
+		return releaseAsset{}, errors.Errorf("GitHub API returned status: %d", resp.StatusCode)
 	}
 
 	var rel release
 	if err := json.NewDecoder(resp.Body).Decode(&rel); err != nil {
-		return "", errors.Errorf("failed to decode JSON: %w", err)
+		return releaseAsset{}, errors.Errorf("failed to decode JSON: %w", err)
 	}
 
-	// Find the matching binary for this system
+	// Find the matching binary and its checksum for this system
+	var relAsset releaseAsset
 	for _, asset := range rel.Assets {
-		if asset.Name == expectedAssetName {
-			return asset.BrowserDownloadURL, nil
+		switch asset.Name {
+		case expectedAssetName:
+			relAsset.url = asset.BrowserDownloadURL
+			relAsset.digest = asset.Digest
+		case expectedAssetName + checksumExt:
+			relAsset.checksumURL = asset.BrowserDownloadURL
 		}
 	}
+	if relAsset.url == "" {
+		return releaseAsset{}, errors.Errorf("no matching asset found for %s", expectedAssetName)
+	}
+	return relAsset, nil
+}
+
+// expectedChecksum returns the published SHA-256 checksum of the release archive.
+func expectedChecksum(relAsset releaseAsset) (string, error) {
+	if relAsset.checksumURL == "" {
+		if strings.HasPrefix(relAsset.digest, digestPrefix) {
+			return strings.TrimPrefix(relAsset.digest, digestPrefix), nil
+		}
+		return "", errors.Errorf("no published checksum found for %s", relAsset.url)
+	}
+
+	resp, err := httpClient.Get(relAsset.checksumURL)
+	if err != nil {
+		return "", errors.Errorf("failed to download checksum: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("checksum download failed: HTTP %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Errorf("failed to read checksum: %w", err)
+	}
+	return parseChecksum(data)
+}
+
+// parseChecksum parses a SHA-256 checksum file in the sha256sum format (<checksum>  <file name>).
+func parseChecksum(data []byte) (string, error) {
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return "", errors.New("empty checksum file")
+	}
+	checksum := strings.ToLower(fields[0])
+	if b, err := hex.DecodeString(checksum); err != nil || len(b) != sha256.Size {
+		return "", errors.Errorf("invalid SHA-256 checksum: %s", fields[0])
+	}
+	return checksum, nil
+}
+
+// fileChecksum returns the SHA-256 checksum of a file.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
 
-	return "", errors.Errorf("no matching asset found for os=%s arch=%s", osMapped, archMapped)
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyChecksum checks the file matches the expected SHA-256 checksum.
+func verifyChecksum(path, expected string) error {
+	checksum, err := fileChecksum(path)
+	if err != nil {
+		return errors.Errorf("failed to compute %s checksum: %w", path, err)
+	}
+	if !strings.EqualFold(checksum, expected) {
+		return errors.Wrapf(ErrChecksumMismatch, "%s: expected %s, got %s", filepath.Base(path), expected, checksum)
+	}
+	return nil
 }
 
-// downloadAndExtractHermes downloads a tar.gz archive and extracts the hermes binary to the cache.
-func downloadAndExtractHermes(downloadURL, version string) (string, error) {
-	tmpFile := filepath.Join(os.TempDir(), filepath.Base(downloadURL))
+// verifyCachedBin checks the cached binary matches the checksum saved next to it.
+func verifyCachedBin(binPath string) error {
+	data, err := os.ReadFile(binPath + checksumExt)
+	if err != nil {
+		return errors.Errorf("failed to read cached checksum: %w", err)
+	}
+	checksum, err := parseChecksum(data)
+	if err != nil {
+		return err
+	}
+	return verifyChecksum(binPath, checksum)
+}
 
-	// Download archive
-	out, err := os.Create(tmpFile)
+// writeBinChecksum saves the checksum of the binary next to it, in the sha256sum format.
+func writeBinChecksum(binPath string) error {
+	checksum, err := fileChecksum(binPath)
+	if err != nil {
+		return errors.Errorf("failed to compute %s checksum: %w", binPath, err)
+	}
+	data := fmt.Sprintf("%s  %s\n", checksum, filepath.Base(binPath))
+	if err := os.WriteFile(binPath+checksumExt, []byte(data), 0o644); err != nil {
+		return errors.Errorf("failed to cache hermes checksum: %w", err)
+	}
+	return nil
+}
+
+// downloadAndExtractHermes downloads a tar.gz archive, verifies its checksum and
+// extracts the hermes binary to the cache.
+func downloadAndExtractHermes(downloadURL, version, checksum string) (string, error) {
+	cachePath, err := binCachePath(version)
+	if err != nil {
+		return "", err
+	}
+
+	tmpFile, err := os.CreateTemp("", "hermes-*"+archiveExt)
 	if err != nil {
 		return "", errors.Errorf("failed to create temp file: %w", err)
 	}
-	defer out.Close()
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
 
 	resp, err := httpClient.Get(downloadURL)
 	if err != nil {
@@ -167,32 +348,60 @@ func downloadAndExtractHermes(downloadURL, version string) (string, error) {
 		return "", errors.Errorf("download failed: HTTP %d", resp.StatusCode)
 	}
 
-	if _, err := io.Copy(out, resp.Body); err != nil {
+	if _, err := io.Copy(tmpFile, resp.Body); err != nil {
+		return "", errors.Errorf("failed to save downloaded binary: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
 		return "", errors.Errorf("failed to save downloaded binary: %w", err)
 	}
 
-	return extractHermesBinary(tmpFile, version)
+	// verify the archive before extracting anything
+	if err := verifyChecksum(tmpFile.Name(), checksum); err != nil {
+		return "", err
+	}
+
+	if err := extractHermesBinary(tmpFile.Name(), cachePath); err != nil {
+		return "", err
+	}
+
+	// save the checksum of the verified binary to use the cache offline
+	if err := writeBinChecksum(cachePath); err != nil {
+		return "", err
+	}
+	return cachePath, nil
+}
+
+// extractHermesBinary unpacks the hermes binary from the given tar.gz archive into the binary path.
+func extractHermesBinary(tarGzPath, binPath string) error {
+	return readHermesBinary(tarGzPath, func(r io.Reader) error {
+		outFile, err := os.OpenFile(binPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+		if err != nil {
+			return errors.Errorf("failed to create hermes binary: %w", err)
+		}
+		defer outFile.Close()
+
+		if _, err := io.Copy(outFile, r); err != nil {
+			return errors.Errorf("failed to extract hermes binary: %w", err)
+		}
+		return nil
+	})
 }
 
-// extractHermesBinary unpacks the hermes binary from the given tar.gz archive.
-func extractHermesBinary(tarGzPath, version string) (string, error) {
+// readHermesBinary calls read with the content of the hermes binary from the given tar.gz archive.
+func readHermesBinary(tarGzPath string, read func(io.Reader) error) error {
 	f, err := os.Open(tarGzPath)
 	if err != nil {
-		return "", errors.Errorf("failed to open tar.gz file: %w", err)
+		return errors.Errorf("failed to open tar.gz file: %w", err)
 	}
 	defer f.Close()
 
 	gzr, err := gzip.NewReader(f)
 	if err != nil {
-		return "", errors.Errorf("failed to create gzip reader: %w", err)
+		return errors.Errorf("failed to create gzip reader: %w", err)
 	}
 	defer gzr.Close()
 
 	tarReader := tar.NewReader(gzr)
-	cachePath, err := binCachePath(version)
-	if err != nil {
-		return "", err
-	}
 
 	// Iterate over files in the tar archive
 	for {
@@ -201,32 +410,40 @@ func extractHermesBinary(tarGzPath, version string) (string, error) {
 			break // end of archive
 		}
 		if err != nil {
-			return "", errors.Errorf("error reading tar archive: %w", err)
+			return errors.Errorf("error reading tar archive: %w", err)
 		}
 
 		// Look for the binary named "hermes"
 		if strings.HasSuffix(header.Name, "/hermes") || header.Name == "hermes" {
-			outFile, err := os.OpenFile(cachePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
-			if err != nil {
-				return "", errors.Errorf("failed to create hermes binary: %w", err)
-			}
-			defer outFile.Close()
-
-			if _, err := io.Copy(outFile, tarReader); err != nil {
-				return "", errors.Errorf("failed to extract hermes binary: %w", err)
-			}
-			return cachePath, nil
+			return read(tarReader)
 		}
 	}
-	return "", errors.Errorf("hermes binary not found in archive")
+	return errors.Errorf("hermes binary not found in archive")
 }
 
 // New returns a usable Hermes instance for the given version (or default version if empty).
-func New(version string) (*Hermes, error) {
+func New(version string, options ...BinOption) (*Hermes, error) {
 	if version == "" {
 		version = DefaultVersion
 	}
-	binPath, err := hermesBin(version)
+
+	var o binOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if o.binary != "" {
+		stat, err := os.Stat(o.binary)
+		if err != nil {
+			return nil, errors.Errorf("failed to get hermes binary %s: %w", o.binary, err)
+		}
+		if stat.IsDir() || stat.Mode()&0o111 == 0 {
+			return nil, errors.Errorf("hermes binary %s is not an executable file", o.binary)
+		}
+		return &Hermes{path: o.binary, version: version}, nil
+	}
+
+	binPath, err := hermesBin(version, o.mirrorURL)
 	if err != nil {
 		return nil, errors.Errorf("failed to get hermes binary: %w", err)
 	}
@@ -0,0 +1,161 @@
+package hermes
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeHermesArchive writes a release archive containing the hermes binary data.
+func writeHermesArchive(t *testing.T, path string, binData []byte) {
+	t.Helper()
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	gzw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gzw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "bin/hermes", Mode: 0o755, Size: int64(len(binData))}))
+	_, err = tw.Write(binData)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	require.NoError(t, f.Close())
+}
+
+func TestParseChecksum(t *testing.T) {
+	checksum := sha256Hex([]byte("hermes"))
+	tests := []struct {
+		name string
+		data string
+		want string
+		err  string
+	}{
+		{
+			name: "sha256sum format",
+			data: fmt.Sprintf("%s  hermes-v1.13.1-x86_64-unknown-linux-gnu.tar.gz\n", checksum),
+			want: checksum,
+		},
+		{
+			name: "checksum only",
+			data: checksum,
+			want: checksum,
+		},
+		{
+			name: "empty file",
+			data: "\n",
+			err:  "empty checksum file",
+		},
+		{
+			name: "invalid checksum",
+			data: "abc  hermes.tar.gz",
+			err:  "invalid SHA-256 checksum: abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChecksum([]byte(tt.data))
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("hermes binary")
+	path := filepath.Join(t.TempDir(), "hermes")
+	require.NoError(t, os.WriteFile(path, data, 0o755))
+
+	require.NoError(t, verifyChecksum(path, sha256Hex(data)))
+
+	err := verifyChecksum(path, sha256Hex([]byte("tampered")))
+	require.True(t, errors.Is(err, ErrChecksumMismatch))
+}
+
+func TestVerifyCachedBin(t *testing.T) {
+	binPath := filepath.Join(t.TempDir(), "v1.13.1")
+	require.NoError(t, os.WriteFile(binPath, []byte("hermes binary"), 0o755))
+
+	// binaries cached without their checksum are fetched again
+	require.Error(t, verifyCachedBin(binPath))
+
+	require.NoError(t, writeBinChecksum(binPath))
+	require.NoError(t, verifyCachedBin(binPath))
+
+	// the cached binary must match the saved checksum
+	require.NoError(t, os.WriteFile(binPath, []byte("tampered"), 0o755))
+	err := verifyCachedBin(binPath)
+	require.True(t, errors.Is(err, ErrChecksumMismatch))
+}
+
+func TestExpectedChecksum(t *testing.T) {
+	checksum := sha256Hex([]byte("archive"))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.13.1/hermes.tar.gz.sha256" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s  hermes.tar.gz\n", checksum)
+	}))
+	defer server.Close()
+
+	got, err := expectedChecksum(releaseAsset{checksumURL: server.URL + "/v1.13.1/hermes.tar.gz.sha256"})
+	require.NoError(t, err)
+	require.Equal(t, checksum, got)
+
+	got, err = expectedChecksum(releaseAsset{digest: digestPrefix + checksum})
+	require.NoError(t, err)
+	require.Equal(t, checksum, got)
+
+	_, err = expectedChecksum(releaseAsset{checksumURL: server.URL + "/missing.sha256"})
+	require.EqualError(t, err, "checksum download failed: HTTP 404")
+
+	_, err = expectedChecksum(releaseAsset{url: "https://example.com/hermes.tar.gz"})
+	require.EqualError(t, err, "no published checksum found for https://example.com/hermes.tar.gz")
+}
+
+func TestGetMirrorAsset(t *testing.T) {
+	assetName, err := hermesAssetName("v1.13.1")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	got, err := getMirrorAsset("http://files.local/hermes", "v1.13.1")
+	require.NoError(t, err)
+	require.Equal(t, releaseAsset{
+		url:         "http://files.local/hermes/v1.13.1/" + assetName,
+		checksumURL: "http://files.local/hermes/v1.13.1/" + assetName + checksumExt,
+	}, got)
+}
+
+func TestExtractHermesBinary(t *testing.T) {
+	var (
+		dir         = t.TempDir()
+		archivePath = filepath.Join(dir, "hermes.tar.gz")
+		binPath     = filepath.Join(dir, "bin")
+		binData     = []byte("hermes binary")
+	)
+
+	writeHermesArchive(t, archivePath, binData)
+	require.NoError(t, extractHermesBinary(archivePath, binPath))
+	got, err := os.ReadFile(binPath)
+	require.NoError(t, err)
+	require.Equal(t, binData, got)
+}
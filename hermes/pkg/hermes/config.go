@@ -1,12 +1,13 @@
 package hermes
 
 import (
+	"bytes"
 	"context"
-	"fmt"
 	"math/big"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -176,12 +177,16 @@ func (c *Config) Save() error {
 	if err != nil {
 		return err
 	}
+	return c.WriteFile(configPath)
+}
 
-	if err := os.MkdirAll(filepath.Dir(configPath), 0o755); err != nil {
+// WriteFile writes the Hermes config file into the path.
+func (c *Config) WriteFile(cfgPath string) error {
+	if err := os.MkdirAll(filepath.Dir(cfgPath), 0o755); err != nil {
 		return err
 	}
 
-	file, err := os.OpenFile(configPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	file, err := os.OpenFile(cfgPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
 	if err != nil {
 		return err
 	}
@@ -190,6 +195,50 @@ func (c *Config) Save() error {
 	return toml.NewEncoder(file).Encode(c)
 }
 
+// Encode returns the Hermes config encoded as TOML.
+func (c *Config) Encode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(c); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Validate validates the Hermes config chains.
+func (c *Config) Validate() error {
+	if len(c.Chains) < 2 {
+		return errors.New("the config must have at least two chains")
+	}
+
+	chainIDs := make(map[string]struct{})
+	for _, chain := range c.Chains {
+		if chain.ID == "" {
+			return errors.New("chain id cannot be empty")
+		}
+		if _, ok := chainIDs[chain.ID]; ok {
+			return errors.Errorf("duplicated chain %s", chain.ID)
+		}
+		chainIDs[chain.ID] = struct{}{}
+
+		switch {
+		case chain.RPCAddr == "":
+			return errors.Errorf("chain %s rpc address cannot be empty", chain.ID)
+		case chain.GRPCAddr == "":
+			return errors.Errorf("chain %s grpc address cannot be empty", chain.ID)
+		case chain.KeyName == "":
+			return errors.Errorf("chain %s key name cannot be empty", chain.ID)
+		case chain.GasPrice.Denom == "":
+			return errors.Errorf("chain %s gas price denom cannot be empty", chain.ID)
+		}
+		for _, addr := range []string{chain.RPCAddr, chain.GRPCAddr, chain.EventSource.URL} {
+			if _, err := url.Parse(addr); err != nil {
+				return errors.Errorf("chain %s invalid address %s: %w", chain.ID, addr, err)
+			}
+		}
+	}
+	return nil
+}
+
 // ConfigName returns the config file name based on the chains inside the config file.
 func (c *Config) ConfigName() (string, error) {
 	if len(c.Chains) < 2 {
@@ -237,13 +286,74 @@ func ConfigFilePath(cfgName string) (string, error) {
 	), nil
 }
 
+// FindConfigs returns the config file paths relaying all the chains.
+func FindConfigs(chainIDs ...string) ([]string, error) {
+	cfgPath, err := configPath()
+	if err != nil {
+		return nil, err
+	}
+	return findConfigs(cfgPath, chainIDs...)
+}
+
+// findConfigs returns the config file paths from the directory relaying all the chains.
+// The config file names are the chain ids joined by the ConfigNameSeparator.
+func findConfigs(dir string, chainIDs ...string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	cfgPaths := make([]string, 0)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		names := strings.Split(entry.Name(), ConfigNameSeparator)
+		if slices.ContainsFunc(chainIDs, func(chainID string) bool {
+			return !slices.Contains(names, chainID)
+		}) {
+			continue
+		}
+		cfgPaths = append(cfgPaths, filepath.Join(dir, entry.Name()))
+	}
+	return cfgPaths, nil
+}
+
+// ParseConfig decodes and validates a TOML Hermes config.
+// Fields not supported by the Config struct are rejected.
+func ParseConfig(data []byte) (*Config, error) {
+	cfg := &Config{}
+	decoder := toml.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil {
+		var (
+			strictErr *toml.StrictMissingError
+			decodeErr *toml.DecodeError
+		)
+		switch {
+		case errors.As(err, &strictErr):
+			return nil, errors.Errorf("unsupported config fields:\n%s", strictErr.String())
+		case errors.As(err, &decodeErr):
+			return nil, errors.Errorf("invalid config:\n%s", decodeErr.String())
+		default:
+			return nil, err
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // LoadConfig loads a config from the path.
 func LoadConfig(cfgPath string) (*Config, error) {
 	cfgBytes, err := os.ReadFile(cfgPath)
 	if err != nil {
 		return nil, err
 	}
-	var cfg *Config
+	cfg := &Config{}
 	return cfg, toml.Unmarshal(cfgBytes, cfg)
 }
 
@@ -565,7 +675,11 @@ func WithChainAddressType(derivation string) ChainOption {
 
 // AddChain adds a new chain into the Hermes config.
 func (c *Config) AddChain(chainID, rpcAddr, grpcAddr string, options ...ChainOption) (Chain, error) {
-	rpcURL, err := url.Parse(rpcAddr)
+	if _, err := c.Chains.Get(chainID); err == nil {
+		return Chain{}, errors.Errorf("chain %s already exist", chainID)
+	}
+
+	eventSourceURL, err := EventSourceURL(rpcAddr)
 	if err != nil {
 		return Chain{}, err
 	}
@@ -577,7 +691,7 @@ func (c *Config) AddChain(chainID, rpcAddr, grpcAddr string, options ...ChainOpt
 		EventSource: EventSource{
 			BatchDelay: "500ms",
 			Mode:       "push",
-			URL:        fmt.Sprintf("ws://%s/websocket", rpcURL.Host),
+			URL:        eventSourceURL,
 		},
 		RPCTimeout:    "15s",
 		AccountPrefix: "cosmos",
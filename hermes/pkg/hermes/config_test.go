@@ -0,0 +1,115 @@
+package hermes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindConfigs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"hub-1_spoke-1", "hub-1_spoke-1_spoke-2", "osmo-1_spoke-2"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o644))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "hub-1_osmo-1"), 0o755))
+
+	got, err := findConfigs(dir, "spoke-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "hub-1_spoke-1"),
+		filepath.Join(dir, "hub-1_spoke-1_spoke-2"),
+	}, got)
+
+	got, err = findConfigs(dir, "spoke-2", "hub-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "hub-1_spoke-1_spoke-2")}, got)
+
+	got, err = findConfigs(dir, "hub")
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	got, err = findConfigs(filepath.Join(dir, "missing"), "hub-1")
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	_, err := cfg.AddChain("hub-1", "http://localhost:26657", "http://localhost:9090")
+	require.NoError(t, err)
+	_, err = cfg.AddChain("spoke-1", "http://localhost:26667", "http://localhost:9100")
+	require.NoError(t, err)
+	data, err := cfg.Encode()
+	require.NoError(t, err)
+
+	t.Run("valid config", func(t *testing.T) {
+		got, err := ParseConfig(data)
+		require.NoError(t, err)
+		require.Equal(t, cfg, got)
+	})
+
+	t.Run("unsupported field", func(t *testing.T) {
+		_, err := ParseConfig(append([]byte("[tracing_server]\nenabled = true\n"), data...))
+		require.ErrorContains(t, err, "unsupported config fields")
+	})
+
+	t.Run("invalid toml", func(t *testing.T) {
+		_, err := ParseConfig([]byte("[global\nlog_level = 'info'"))
+		require.ErrorContains(t, err, "invalid config")
+	})
+
+	t.Run("invalid chain", func(t *testing.T) {
+		invalid := *cfg
+		invalid.Chains = Chains{cfg.Chains[0], cfg.Chains[1]}
+		invalid.Chains[1].KeyName = ""
+		data, err := invalid.Encode()
+		require.NoError(t, err)
+		_, err = ParseConfig(data)
+		require.EqualError(t, err, "chain spoke-1 key name cannot be empty")
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	chains := Chains{
+		{ID: "hub-1", RPCAddr: "http://localhost:26657", GRPCAddr: "http://localhost:9090", KeyName: "wallet", GasPrice: GasPrice{Denom: "stake"}},
+		{ID: "spoke-1", RPCAddr: "http://localhost:26667", GRPCAddr: "http://localhost:9100", KeyName: "wallet", GasPrice: GasPrice{Denom: "stake"}},
+	}
+	tests := []struct {
+		name   string
+		chains Chains
+		err    string
+	}{
+		{
+			name:   "valid config",
+			chains: chains,
+		},
+		{
+			name:   "single chain",
+			chains: chains[:1],
+			err:    "the config must have at least two chains",
+		},
+		{
+			name:   "duplicated chain",
+			chains: Chains{chains[0], chains[0]},
+			err:    "duplicated chain hub-1",
+		},
+		{
+			name:   "missing grpc address",
+			chains: Chains{chains[0], {ID: "spoke-1", RPCAddr: "http://localhost:26667"}},
+			err:    "chain spoke-1 grpc address cannot be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Chains: tt.chains}
+			err := cfg.Validate()
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
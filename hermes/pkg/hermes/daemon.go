@@ -0,0 +1,462 @@
+package hermes
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	osexec "os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/config"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
+)
+
+const (
+	// SupervisorCommand is the app binary argument running the detached relayer supervisor.
+	SupervisorCommand = "hermes-supervisor"
+
+	runPathDirectory  = "run"
+	logsPathDirectory = "logs"
+
+	logMaxSize     = 10 << 20
+	logMaxFiles    = 5
+	tailChunkSize  = 4096
+	followInterval = 500 * time.Millisecond
+
+	initialBackoff = time.Second
+	maxBackoff     = time.Minute
+	stableRunTime  = time.Minute
+	stopTimeout    = 10 * time.Second
+)
+
+// ErrNotRunning indicates that the detached relayer is not running.
+var ErrNotRunning = errors.New("relayer is not running")
+
+// Daemon represents a detached relayer for a config file, supervised in
+// background with a PID file and rotated log files named by the config.
+type Daemon struct {
+	name    string
+	pidFile string
+	logFile string
+}
+
+// NewDaemon returns the detached relayer for the config file.
+func NewDaemon(cfgPath string) (Daemon, error) {
+	name := filepath.Base(cfgPath)
+	runPath, err := appPath(runPathDirectory)
+	if err != nil {
+		return Daemon{}, err
+	}
+	logsPath, err := appPath(logsPathDirectory)
+	if err != nil {
+		return Daemon{}, err
+	}
+	return Daemon{
+		name:    name,
+		pidFile: filepath.Join(runPath, name+".pid"),
+		logFile: filepath.Join(logsPath, name+".log"),
+	}, nil
+}
+
+// appPath returns a directory path under the hermes app directory.
+func appPath(dir string) (string, error) {
+	return xfilepath.Join(config.DirPath, xfilepath.Path(hermesDirectory), xfilepath.Path(dir))()
+}
+
+// Name returns the daemon config name.
+func (d Daemon) Name() string {
+	return d.name
+}
+
+// LogFile returns the current log file path.
+func (d Daemon) LogFile() string {
+	return d.logFile
+}
+
+// PID returns the supervisor process id, or ErrNotRunning if the relayer is
+// not running. Stale PID files are removed.
+func (d Daemon) PID() (int, error) {
+	data, err := os.ReadFile(d.pidFile)
+	if os.IsNotExist(err) {
+		return 0, ErrNotRunning
+	} else if err != nil {
+		return 0, err
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, errors.Errorf("invalid pid file %s: %w", d.pidFile, err)
+	}
+	if !processAlive(pid) {
+		_ = os.Remove(d.pidFile)
+		return 0, ErrNotRunning
+	}
+	return pid, nil
+}
+
+// Start starts the relayer supervisor as a detached process, running the app
+// binary with the SupervisorCommand argument. If restart is true, the relayer
+// is restarted with backoff when it crashes.
+func (d Daemon) Start(h *Hermes, cfgPath string, restart bool) (int, error) {
+	if pid, err := d.PID(); err == nil {
+		return 0, errors.Errorf("relayer %s already running (pid %d)", d.name, pid)
+	} else if !errors.Is(err, ErrNotRunning) {
+		return 0, err
+	}
+
+	for _, dir := range []string{filepath.Dir(d.pidFile), filepath.Dir(d.logFile)} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return 0, err
+		}
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return 0, err
+	}
+	cmd := osexec.Command(
+		executable,
+		SupervisorCommand,
+		"-hermes", h.path,
+		"-config", cfgPath,
+		"-log-file", d.logFile,
+		"-pid-file", d.pidFile,
+		fmt.Sprintf("-restart=%t", restart),
+	)
+	// run the supervisor in a new session to survive the app process
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	pid := cmd.Process.Pid
+	if err := os.WriteFile(d.pidFile, []byte(strconv.Itoa(pid)), 0o644); err != nil {
+		_ = cmd.Process.Kill()
+		return 0, err
+	}
+	return pid, cmd.Process.Release()
+}
+
+// Stop stops the relayer supervisor and the relayer, and waits for both to exit.
+// The supervisor runs in its own session, so its whole process group is signaled
+// and killed if it doesn't exit before the stop timeout.
+func (d Daemon) Stop() error {
+	pid, err := d.PID()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(d.pidFile)
+
+	if err := syscall.Kill(-pid, syscall.SIGTERM); err != nil {
+		return err
+	}
+	if waitProcessGroup(pid, stopTimeout) {
+		return nil
+	}
+	if err := syscall.Kill(-pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return err
+	}
+	if !waitProcessGroup(pid, stopTimeout) {
+		return errors.Errorf("relayer %s (pid %d) did not exit", d.name, pid)
+	}
+	return nil
+}
+
+// Logs returns the last n lines of the current log file.
+func (d Daemon) Logs(n int) ([]string, error) {
+	return TailLines(d.logFile, n)
+}
+
+// FollowLogs writes the new log lines to the writer until the context is done.
+// The current log file is reopened when it is rotated.
+func (d Daemon) FollowLogs(ctx context.Context, w io.Writer) error {
+	f, err := os.Open(d.logFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := io.Copy(w, f); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		latest, err := os.Stat(d.logFile)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if !os.SameFile(current, latest) {
+			// flush the rotated file before following the new one
+			if _, err := io.Copy(w, f); err != nil {
+				return err
+			}
+			_ = f.Close()
+			if f, err = os.Open(d.logFile); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Supervise runs the Hermes relayer for the config file writing its output to the writer.
+// If restart is true, the relayer is restarted with exponential backoff when it exits
+// with an error. It returns when the relayer exits or the context is done.
+func (h *Hermes) Supervise(ctx context.Context, cfgPath string, w io.Writer, restart bool) error {
+	backoff := initialBackoff
+	for {
+		started := time.Now()
+		err := h.runRelayer(ctx, cfgPath, w)
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err == nil:
+			return nil
+		case !restart:
+			return err
+		}
+
+		// reset the backoff if the relayer was running fine for a while
+		if time.Since(started) >= stableRunTime {
+			backoff = initialBackoff
+		}
+		logSupervisor(w, "relayer exited: %s, restarting in %s", err, backoff)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// runRelayer runs the Hermes start command without buffering its output,
+// stopping the relayer gracefully when the context is done.
+func (h *Hermes) runRelayer(ctx context.Context, cfgPath string, w io.Writer) error {
+	cmd := osexec.CommandContext(
+		ctx,
+		h.path,
+		fmt.Sprintf("--%s=%s", FlagConfig, cfgPath),
+		string(cmdStart),
+		fmt.Sprintf("--%s", FlagFullScan),
+	)
+	cmd.Stdout = w
+	cmd.Stderr = w
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(syscall.SIGTERM)
+	}
+	cmd.WaitDelay = stopTimeout
+	return cmd.Run()
+}
+
+// RunSupervisor runs the detached relayer supervisor from the SupervisorCommand arguments.
+func RunSupervisor(args []string) error {
+	var (
+		fs      = flag.NewFlagSet(SupervisorCommand, flag.ContinueOnError)
+		bin     = fs.String("hermes", "", "hermes binary path")
+		cfgPath = fs.String("config", "", "hermes config file path")
+		logFile = fs.String("log-file", "", "relayer log file path")
+		pidFile = fs.String("pid-file", "", "supervisor pid file path")
+		restart = fs.Bool("restart", false, "restart the relayer with backoff when it crashes")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	defer os.Remove(*pidFile)
+
+	logs, err := newRotatingFile(*logFile, logMaxSize, logMaxFiles)
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	h := &Hermes{path: *bin}
+	logSupervisor(logs, "starting relayer (pid %d)", os.Getpid())
+	if err := h.Supervise(ctx, *cfgPath, logs, *restart); err != nil {
+		logSupervisor(logs, "relayer exited: %s", err)
+		return err
+	}
+	logSupervisor(logs, "relayer stopped")
+	return nil
+}
+
+func logSupervisor(w io.Writer, format string, args ...any) {
+	_, _ = fmt.Fprintf(w, "%s supervisor: %s\n", time.Now().UTC().Format(time.RFC3339), fmt.Sprintf(format, args...))
+}
+
+// nextBackoff doubles the restart backoff up to the max backoff.
+func nextBackoff(backoff time.Duration) time.Duration {
+	return min(backoff*2, maxBackoff)
+}
+
+// processAlive returns true if a process with the pid exists.
+func processAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// processGroupAlive returns true if a process of the process group exists.
+func processGroupAlive(pgid int) bool {
+	return !errors.Is(syscall.Kill(-pgid, syscall.Signal(0)), syscall.ESRCH)
+}
+
+// waitProcessGroup waits until all the processes of the process group exit,
+// returning false if they are still running after the timeout.
+func waitProcessGroup(pgid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for processGroupAlive(pgid) {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return true
+}
+
+// rotatingFile is a log file writer rotating the file when it reaches the max size.
+// The rotated files are suffixed with their number, keeping up to max files.
+type rotatingFile struct {
+	mu       sync.Mutex
+	path     string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxFiles int) (*rotatingFile, error) {
+	r := &rotatingFile{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	return r, r.open()
+}
+
+func (r *rotatingFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	r.file = file
+	r.size = stat.Size()
+	return nil
+}
+
+// Write writes to the log file, rotating it before if the data exceeds the max size.
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	for i := r.maxFiles - 1; i >= 1; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+// Close closes the log file.
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+// TailLines returns the last n lines of a file, reading it backwards.
+func TailLines(path string, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		offset = stat.Size()
+		data   []byte
+	)
+	// read chunks from the end until the data has n complete lines
+	for offset > 0 && bytes.Count(bytes.TrimSuffix(data, []byte("\n")), []byte("\n")) < n {
+		size := min(int64(tailChunkSize), offset)
+		offset -= size
+		chunk := make([]byte, size)
+		if _, err := f.ReadAt(chunk, offset); err != nil {
+			return nil, err
+		}
+		data = append(chunk, data...)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines, nil
+}
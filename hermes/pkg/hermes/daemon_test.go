@@ -0,0 +1,134 @@
+package hermes
+
+import (
+	"fmt"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTailLines(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		path  = filepath.Join(dir, "hermes.log")
+		lines = make([]string, 0)
+	)
+	// lines bigger than the read chunk size
+	for i := 0; i < 3000; i++ {
+		lines = append(lines, fmt.Sprintf("line %d %s", i, strings.Repeat("x", i%50)))
+	}
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644))
+
+	got, err := TailLines(path, 10)
+	require.NoError(t, err)
+	require.Equal(t, lines[len(lines)-10:], got)
+
+	got, err = TailLines(path, 5000)
+	require.NoError(t, err)
+	require.Equal(t, lines, got)
+
+	got, err = TailLines(path, 0)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	empty := filepath.Join(dir, "empty.log")
+	require.NoError(t, os.WriteFile(empty, nil, 0o644))
+	got, err = TailLines(empty, 10)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	_, err = TailLines(filepath.Join(dir, "missing.log"), 10)
+	require.Error(t, err)
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hermes.log")
+	r, err := newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, data := range []string{"aaaaaa\n", "bbbbbb\n", "cccccc\n", "dddddd\n"} {
+		_, err := r.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, r.Close())
+
+	for file, want := range map[string]string{
+		path:        "dddddd\n",
+		path + ".1": "cccccc\n",
+		path + ".2": "bbbbbb\n",
+	} {
+		got, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.Equal(t, want, string(got))
+	}
+	require.NoFileExists(t, path+".3")
+}
+
+func TestNextBackoff(t *testing.T) {
+	require.Equal(t, 2*time.Second, nextBackoff(initialBackoff))
+	require.Equal(t, maxBackoff, nextBackoff(40*time.Second))
+	require.Equal(t, maxBackoff, nextBackoff(maxBackoff))
+}
+
+func TestDaemonPID(t *testing.T) {
+	dir := t.TempDir()
+	d := Daemon{name: "hub-1_spoke-1", pidFile: filepath.Join(dir, "hub-1_spoke-1.pid")}
+
+	_, err := d.PID()
+	require.ErrorIs(t, err, ErrNotRunning)
+
+	require.NoError(t, os.WriteFile(d.pidFile, []byte(strconv.Itoa(os.Getpid())), 0o644))
+	pid, err := d.PID()
+	require.NoError(t, err)
+	require.Equal(t, os.Getpid(), pid)
+
+	// stale pid files are removed
+	require.NoError(t, os.WriteFile(d.pidFile, []byte("999999999"), 0o644))
+	_, err = d.PID()
+	require.ErrorIs(t, err, ErrNotRunning)
+	require.NoFileExists(t, d.pidFile)
+}
+
+func TestDaemonStop(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		childPID = filepath.Join(dir, "child.pid")
+		d        = Daemon{name: "hub-1_spoke-1", pidFile: filepath.Join(dir, "hub-1_spoke-1.pid")}
+	)
+
+	// the fake supervisor doesn't forward the signal to its child, it only waits for it to exit
+	supervisor := osexec.Command("sh", "-c", fmt.Sprintf(
+		`trap 'wait $child; exit 0' TERM; sleep 30 & child=$!; echo $child > %s; wait`,
+		childPID,
+	))
+	supervisor.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	require.NoError(t, supervisor.Start())
+	exited := make(chan error, 1)
+	go func() {
+		exited <- supervisor.Wait()
+	}()
+	require.NoError(t, os.WriteFile(d.pidFile, []byte(strconv.Itoa(supervisor.Process.Pid)), 0o644))
+
+	var child int
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(childPID)
+		if err != nil {
+			return false
+		}
+		child, err = strconv.Atoi(strings.TrimSpace(string(data)))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, d.Stop())
+	// the supervisor exits gracefully once its child is stopped, without being killed
+	require.NoError(t, <-exited)
+	require.False(t, processAlive(child))
+	require.NoFileExists(t, d.pidFile)
+}
@@ -0,0 +1,106 @@
+package hermes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around the changes.
+const diffContext = 3
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// Diff returns the line diff between two texts, with the removed lines prefixed by
+// "-", the added lines prefixed by "+" and the unchanged lines around the changes.
+// It returns an empty string if the texts are equal.
+func Diff(from, to string) string {
+	lines := diffLines(splitLines(from), splitLines(to))
+
+	// keep the unchanged lines close to a change
+	show := make([]bool, len(lines))
+	changed := false
+	for i, line := range lines {
+		if line.op == ' ' {
+			continue
+		}
+		changed = true
+		for j := max(0, i-diffContext); j <= min(len(lines)-1, i+diffContext); j++ {
+			show[j] = true
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var (
+		b       strings.Builder
+		skipped = false
+	)
+	for i, line := range lines {
+		if !show[i] {
+			skipped = true
+			continue
+		}
+		if skipped {
+			b.WriteString("...\n")
+			skipped = false
+		}
+		_, _ = fmt.Fprintf(&b, "%c %s\n", line.op, line.text)
+	}
+	if skipped {
+		b.WriteString("...\n")
+	}
+	return b.String()
+}
+
+// diffLines computes the line operations from the longest common subsequence.
+func diffLines(a, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{op: ' ', text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{op: '-', text: a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{op: '+', text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{op: '-', text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{op: '+', text: b[j]})
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,52 @@
+package hermes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{
+			name: "equal texts",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+			want: "",
+		},
+		{
+			name: "changed line",
+			from: "a\nb\nc\n",
+			to:   "a\nB\nc\n",
+			want: "  a\n- b\n+ B\n  c\n",
+		},
+		{
+			name: "added and removed lines",
+			from: "a\nb\n",
+			to:   "b\nc\n",
+			want: "- a\n  b\n+ c\n",
+		},
+		{
+			name: "unchanged lines far from the changes",
+			from: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			to:   "1\n2\n3\n4\n5\nfive\n7\n8\n9\n10\n",
+			want: "...\n  3\n  4\n  5\n- 6\n+ five\n  7\n  8\n  9\n...\n",
+		},
+		{
+			name: "new text",
+			from: "",
+			to:   "a\n",
+			want: "+ a\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Diff(tt.from, tt.to))
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
@@ -31,6 +33,23 @@ const (
 	FlagKeyName          = "key-name"
 	FlagConfig           = "config"
 	FlagFullScan         = "full-scan"
+	FlagCounterparty     = "counterparty-chain"
+	FlagPort             = "port"
+	FlagChannel          = "channel"
+	FlagOrder            = "order"
+	FlagChannelVersion   = "channel-version"
+	FlagDelay            = "delay"
+	FlagClient           = "client"
+	FlagConnection       = "connection"
+	FlagConsensusHeight  = "consensus-height"
+	FlagDstChain         = "dst-chain"
+	FlagSrcChain         = "src-chain"
+	FlagSrcPort          = "src-port"
+	FlagSrcChannel       = "src-channel"
+	FlagAmount           = "amount"
+	FlagDenom            = "denom"
+	FlagReceiver         = "receiver"
+	FlagTimeoutSeconds   = "timeout-seconds"
 )
 
 const (
@@ -46,6 +65,12 @@ const (
 	// CommandStart is the Hermes start command.
 	cmdStart cmdName = "start"
 
+	// cmdClear is the Hermes clear command.
+	cmdClear cmdName = "clear"
+
+	// cmdTx is the Hermes tx command.
+	cmdTx cmdName = "tx"
+
 	// CommandClient is the Hermes create client command.
 	cmdClient subCmd = "client"
 
@@ -58,6 +83,45 @@ const (
 	// CommandChannels  is the Hermes query channels command.
 	cmdChannels subCmd = "channels"
 
+	// cmdClients is the Hermes query clients command.
+	cmdClients subCmd = "clients"
+
+	// cmdConnections is the Hermes query connections command.
+	cmdConnections subCmd = "connections"
+
+	// cmdStatus is the Hermes query client status command.
+	cmdStatus subCmd = "status"
+
+	// cmdState is the Hermes query client state command.
+	cmdState subCmd = "state"
+
+	// cmdConsensus is the Hermes query client consensus command.
+	cmdConsensus subCmd = "consensus"
+
+	// cmdPacket is the Hermes query packet command.
+	cmdPacket subCmd = "packet"
+
+	// cmdPending is the Hermes query packet pending command.
+	cmdPending subCmd = "pending"
+
+	// cmdPackets is the Hermes clear packets command.
+	cmdPackets subCmd = "packets"
+
+	// cmdCommitments is the Hermes query packet commitments command.
+	cmdCommitments subCmd = "commitments"
+
+	// cmdAcks is the Hermes query packet acks command.
+	cmdAcks subCmd = "acks"
+
+	// cmdPacketRecv is the Hermes tx packet-recv command.
+	cmdPacketRecv subCmd = "packet-recv"
+
+	// cmdFtTransfer is the Hermes tx ft-transfer command.
+	cmdFtTransfer subCmd = "ft-transfer"
+
+	// cmdEnd is the Hermes query channel and connection end command.
+	cmdEnd subCmd = "end"
+
 	// CommandKeysAdd is the Hermes keys add command.
 	cmdKeysAdd subCmd = "add"
 
@@ -72,6 +136,17 @@ const (
 
 	// ResultError is the api result status error.
 	ResultError = "error"
+
+	// StateOpen is the open state of an IBC connection or channel.
+	StateOpen = "Open"
+
+	// ClientStatusActive is the status of an active IBC client, neither expired nor frozen.
+	ClientStatusActive = "Active"
+
+	// EventSendPacket is the IBC event emitted when a packet is sent.
+	EventSendPacket = "SendPacket"
+	// EventChainError is the event returned when a chain fails to relay packets.
+	EventChainError = "ChainError"
 )
 
 // ErrResult indicates that Hermes binary returned an error.
@@ -169,6 +244,100 @@ type (
 		Secs  int `json:"secs"`
 	}
 
+	// ClientChain represents the result of the query clients command.
+	ClientChain struct {
+		ClientID string `json:"client_id"`
+		ChainID  string `json:"chain_id"`
+	}
+
+	// ClientState represents the result of the query client state command.
+	ClientState struct {
+		ChainID        string           `json:"chain_id"`
+		TrustingPeriod Time             `json:"trusting_period"`
+		LatestHeight   ConsensusHeight  `json:"latest_height"`
+		FrozenHeight   *ConsensusHeight `json:"frozen_height"`
+	}
+
+	// ConsensusState represents the result of the query client consensus command.
+	ConsensusState struct {
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	// PendingPacketsResult represents the result of the query packet pending command.
+	PendingPacketsResult struct {
+		Src PendingPackets `json:"src"`
+		Dst PendingPackets `json:"dst"`
+	}
+
+	// PendingPackets represents the packets and acknowledgments not yet received by a chain.
+	PendingPackets struct {
+		UnreceivedPackets []uint64 `json:"unreceived_packets"`
+		UnreceivedAcks    []uint64 `json:"unreceived_acks"`
+	}
+
+	// PacketSequences represents the result of the query packet commitments and acks commands.
+	PacketSequences struct {
+		Height ConsensusHeight `json:"height"`
+		Seqs   []uint64        `json:"seqs"`
+	}
+
+	// PacketEvents represents the IBC events result of the clear packets and tx packet-recv commands.
+	PacketEvents []PacketEvent
+
+	// PacketEvent represents an IBC event emitted while relaying packets, e.g. SendPacket,
+	// WriteAcknowledgement or TimeoutPacket. Kind holds the event name and Message the
+	// error message of the ChainError events.
+	PacketEvent struct {
+		Kind    string          `json:"kind"`
+		Packet  Packet          `json:"packet"`
+		Height  ConsensusHeight `json:"height"`
+		Message string          `json:"message,omitempty"`
+	}
+
+	// Packet represents an IBC packet.
+	Packet struct {
+		Sequence           uint64 `json:"sequence"`
+		SourcePort         string `json:"source_port"`
+		SourceChannel      string `json:"source_channel"`
+		DestinationPort    string `json:"destination_port"`
+		DestinationChannel string `json:"destination_channel"`
+	}
+
+	// ConnectionEnd represents the result of the query connection end command.
+	ConnectionEnd struct {
+		State        string                 `json:"state"`
+		ClientID     string                 `json:"client_id"`
+		Counterparty ConnectionCounterparty `json:"counterparty"`
+		DelayPeriod  Time                   `json:"delay_period"`
+	}
+
+	// ConnectionCounterparty represents the counterparty of a connection end.
+	ConnectionCounterparty struct {
+		ClientID     string `json:"client_id"`
+		ConnectionID string `json:"connection_id"`
+	}
+
+	// PortChannelID represents a channel id and its port id.
+	PortChannelID struct {
+		ChannelID string `json:"channel_id"`
+		PortID    string `json:"port_id"`
+	}
+
+	// ChannelEnd represents the result of the query channel end command.
+	ChannelEnd struct {
+		State          string             `json:"state"`
+		Ordering       string             `json:"ordering"`
+		Remote         ChannelCounterpart `json:"remote"`
+		ConnectionHops []string           `json:"connection_hops"`
+		Version        string             `json:"version"`
+	}
+
+	// ChannelCounterpart represents the counterparty of a channel end.
+	ChannelCounterpart struct {
+		PortID    string `json:"port_id"`
+		ChannelID string `json:"channel_id"`
+	}
+
 	// ChannelResult represents the result of the create channel command.
 	ChannelResult struct {
 		ChainIDA string `json:"chain_id_a"`
@@ -351,6 +520,307 @@ func (h *Hermes) QueryChannels(ctx context.Context, showCounterparty bool, chain
 	return h.Run(ctx, options...)
 }
 
+// QueryClients query all Hermes clients hosted by a chain and tracking the reference chain.
+func (h *Hermes) QueryClients(ctx context.Context, hostChain, referenceChain string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdClients)),
+		WithFlags(Flags{
+			FlagHostChain:      hostChain,
+			FlagReferenceChain: referenceChain,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// QueryClientStatus query the client status (active, expired or frozen) based in a chain id and client id.
+func (h *Hermes) QueryClientStatus(ctx context.Context, chain, client string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdClient), string(cmdStatus)),
+		WithFlags(Flags{
+			FlagChain:  chain,
+			FlagClient: client,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// QueryClientState query the client state based in a chain id and client id.
+func (h *Hermes) QueryClientState(ctx context.Context, chain, client string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdClient), string(cmdState)),
+		WithFlags(Flags{
+			FlagChain:  chain,
+			FlagClient: client,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// QueryClientConsensus query the client consensus state at the consensus height.
+func (h *Hermes) QueryClientConsensus(ctx context.Context, chain, client string, height int, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdClient), string(cmdConsensus)),
+		WithFlags(Flags{
+			FlagChain:           chain,
+			FlagClient:          client,
+			FlagConsensusHeight: height,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// QueryPacketPending query the pending packets and acknowledgments of a channel on both sides.
+func (h *Hermes) QueryPacketPending(ctx context.Context, chain, port, channel string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdPacket), string(cmdPending)),
+		WithFlags(Flags{
+			FlagChain:   chain,
+			FlagPort:    port,
+			FlagChannel: channel,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// Total returns the total of pending packets and acknowledgments.
+func (r PendingPacketsResult) Total() int {
+	return len(r.Src.UnreceivedPackets) + len(r.Src.UnreceivedAcks) +
+		len(r.Dst.UnreceivedPackets) + len(r.Dst.UnreceivedAcks)
+}
+
+// QueryPacketCommitments query the sequences of the packets sent through a channel and not yet acknowledged.
+func (h *Hermes) QueryPacketCommitments(ctx context.Context, chain, port, channel string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdPacket), string(cmdCommitments)),
+		WithFlags(Flags{
+			FlagChain:   chain,
+			FlagPort:    port,
+			FlagChannel: channel,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// QueryPacketAcks query the sequences of the packets received through a channel and acknowledged.
+func (h *Hermes) QueryPacketAcks(ctx context.Context, chain, port, channel string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdPacket), string(cmdAcks)),
+		WithFlags(Flags{
+			FlagChain:   chain,
+			FlagPort:    port,
+			FlagChannel: channel,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// ClearPackets relays the pending packets and acknowledgments of a channel on both sides.
+func (h *Hermes) ClearPackets(ctx context.Context, chain, port, channel string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdClear), string(cmdPackets)),
+		WithFlags(Flags{
+			FlagChain:   chain,
+			FlagPort:    port,
+			FlagChannel: channel,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// TxPacketRecv relays the packets sent through the source channel to the destination chain.
+func (h *Hermes) TxPacketRecv(ctx context.Context, dstChain, srcChain, srcPort, srcChannel string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdTx), string(cmdPacketRecv)),
+		WithFlags(Flags{
+			FlagDstChain:   dstChain,
+			FlagSrcChain:   srcChain,
+			FlagSrcPort:    srcPort,
+			FlagSrcChannel: srcChannel,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// FtTransfer sends an ICS-20 fungible token transfer from the source chain relayer key
+// through the source channel. The packet times out on the destination chain after the
+// timeout, rounded down to the second. The receiver is set with the FlagReceiver flag,
+// by default the tokens are sent to the destination chain relayer key.
+func (h *Hermes) FtTransfer(
+	ctx context.Context,
+	dstChain,
+	srcChain,
+	srcPort,
+	srcChannel string,
+	amount uint64,
+	denom string,
+	timeout time.Duration,
+	options ...Option,
+) error {
+	if timeout < time.Second {
+		return errors.Errorf("invalid transfer packet timeout %s, must be at least 1s", timeout)
+	}
+	options = append(
+		options,
+		WithArgs(string(cmdTx), string(cmdFtTransfer)),
+		WithFlags(Flags{
+			FlagDstChain:       dstChain,
+			FlagSrcChain:       srcChain,
+			FlagSrcPort:        srcPort,
+			FlagSrcChannel:     srcChannel,
+			FlagAmount:         amount,
+			FlagDenom:          denom,
+			FlagTimeoutSeconds: uint64(timeout.Seconds()),
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// UnmarshalJSON decodes a Hermes IBC event, with or without its height.
+// Events are encoded by their name, e.g. {"event":{"SendPacket":{"packet":{...}}},"height":{...}}.
+func (e *PacketEvent) UnmarshalJSON(data []byte) error {
+	var withHeight struct {
+		Event  json.RawMessage  `json:"event"`
+		Height *ConsensusHeight `json:"height"`
+	}
+	if err := json.Unmarshal(data, &withHeight); err != nil {
+		return err
+	}
+	if withHeight.Event != nil && withHeight.Height != nil {
+		e.Height = *withHeight.Height
+		data = withHeight.Event
+	}
+
+	var event map[string]json.RawMessage
+	if err := json.Unmarshal(data, &event); err != nil {
+		return err
+	}
+	if len(event) != 1 {
+		return errors.Errorf("invalid packet event: %s", string(data))
+	}
+	for kind, value := range event {
+		e.Kind = kind
+		var attributes struct {
+			Packet *Packet `json:"packet"`
+		}
+		// events without packet, e.g. ChainError, only hold a message
+		if err := json.Unmarshal(value, &e.Message); err == nil {
+			continue
+		}
+		if err := json.Unmarshal(value, &attributes); err == nil && attributes.Packet != nil {
+			e.Packet = *attributes.Packet
+		}
+	}
+	return nil
+}
+
+// Err returns the messages of the ChainError events as an error, or nil if there is none.
+func (e PacketEvents) Err() error {
+	errs := make([]error, 0)
+	for _, event := range e {
+		if event.Kind == EventChainError {
+			errs = append(errs, errors.Errorf("chain error: %s", event.Message))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+// Sequences returns the packet sequences of the events with the kind.
+func (e PacketEvents) Sequences(kind string) []uint64 {
+	seqs := make([]uint64, 0)
+	for _, event := range e {
+		if event.Kind == kind {
+			seqs = append(seqs, event.Packet.Sequence)
+		}
+	}
+	return seqs
+}
+
+// QueryConnections query all Hermes connections between a chain and the counterparty chain.
+func (h *Hermes) QueryConnections(ctx context.Context, chain, counterpartyChain string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdConnections)),
+		WithFlags(Flags{
+			FlagChain:        chain,
+			FlagCounterparty: counterpartyChain,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// QueryConnectionEnd query the connection end based in a chain id and connection id.
+func (h *Hermes) QueryConnectionEnd(ctx context.Context, chain, connection string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdConnection), string(cmdEnd)),
+		WithFlags(Flags{
+			FlagChain:      chain,
+			FlagConnection: connection,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// IsOpen returns true if the connection end is open.
+func (c ConnectionEnd) IsOpen() bool {
+	return strings.EqualFold(c.State, StateOpen)
+}
+
+// Delay returns the connection delay period.
+func (t Time) Delay() time.Duration {
+	return time.Duration(t.Secs)*time.Second + time.Duration(t.Nanos)
+}
+
+// QueryChannelEnd query the channel end based in a chain id, port id and channel id.
+func (h *Hermes) QueryChannelEnd(ctx context.Context, chain, port, channel string, options ...Option) error {
+	options = append(
+		options,
+		WithArgs(string(cmdQuery), string(cmdChannel), string(cmdEnd)),
+		WithFlags(Flags{
+			FlagChain:   chain,
+			FlagPort:    port,
+			FlagChannel: channel,
+		}),
+	)
+	return h.Run(ctx, options...)
+}
+
+// IsOpen returns true if the channel end is open.
+func (c ChannelEnd) IsOpen() bool {
+	return strings.EqualFold(c.State, StateOpen)
+}
+
+// Matches returns true if the channel end relays the path ports with the path ordering and version.
+// The channel end must be queried from the path chain A.
+func (c ChannelEnd) Matches(path Path) bool {
+	ordering := path.Ordering
+	if ordering == "" {
+		ordering = OrderingUnordered
+	}
+	switch {
+	case c.Remote.PortID != path.PortB:
+		return false
+	case !strings.EqualFold(c.Ordering, ordering):
+		return false
+	case path.Version != "" && c.Version != path.Version:
+		return false
+	default:
+		return true
+	}
+}
+
 // Start starts the Hermes relayer.
 func (h *Hermes) Start(ctx context.Context, options ...Option) error {
 	options = append(options, WithArgs(string(cmdStart)), WithFlags(Flags{FlagFullScan: true}))
@@ -379,7 +849,7 @@ func (h *Hermes) Run(ctx context.Context, options ...Option) error {
 		if v, ok := value.(bool); ok && v {
 			cmd = append(cmd, fmt.Sprintf("--%s", flag))
 		} else {
-			cmd = append(cmd, fmt.Sprintf("--%s=%s", flag, value))
+			cmd = append(cmd, fmt.Sprintf("--%s=%v", flag, value))
 		}
 	}
 
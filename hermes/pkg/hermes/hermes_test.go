@@ -1,9 +1,15 @@
 package hermes
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 
@@ -90,3 +96,91 @@ func TestValidateResult(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalConnectionEnd(t *testing.T) {
+	data := []byte(`{"status": "success", "result": {"client_id":"07-tendermint-0","counterparty":{"client_id":"07-tendermint-1","connection_id":"connection-1","prefix":"ibc"},"delay_period":{"nanos":0,"secs":30},"state":"Open","versions":[{"features":["ORDER_ORDERED","ORDER_UNORDERED"],"identifier":"1"}]}}`)
+
+	var connectionEnd ConnectionEnd
+	require.NoError(t, UnmarshalResult(data, &connectionEnd))
+	require.True(t, connectionEnd.IsOpen())
+	require.Equal(t, "07-tendermint-0", connectionEnd.ClientID)
+	require.Equal(t, ConnectionCounterparty{ClientID: "07-tendermint-1", ConnectionID: "connection-1"}, connectionEnd.Counterparty)
+	require.Equal(t, 30*time.Second, connectionEnd.DelayPeriod.Delay())
+}
+
+func TestUnmarshalPacketSequences(t *testing.T) {
+	data := []byte(`{"status": "success", "result": {"height":{"revision_height":1520,"revision_number":0},"seqs":[3,4,7]}}`)
+
+	var commitments PacketSequences
+	require.NoError(t, UnmarshalResult(data, &commitments))
+	require.Equal(t, ConsensusHeight{RevisionHeight: 1520}, commitments.Height)
+	require.Equal(t, []uint64{3, 4, 7}, commitments.Seqs)
+}
+
+func TestUnmarshalPacketEvents(t *testing.T) {
+	packet := `{"sequence":3,"source_port":"transfer","source_channel":"channel-0","destination_port":"transfer","destination_channel":"channel-1","data":[123,125],"timeout_height":"Never","timeout_timestamp":1712000000000000000}`
+	data := []byte(`{"status": "success", "result": [` +
+		`{"event":{"WriteAcknowledgement":{"packet":` + packet + `,"ack":[123,125]}},"height":{"revision_height":1520,"revision_number":0},"tx_hash":"ABCD"},` +
+		`{"TimeoutPacket":{"packet":` + packet + `}},` +
+		`{"ChainError":"account sequence mismatch"}` +
+		`]}`)
+
+	var events PacketEvents
+	require.NoError(t, UnmarshalResult(data, &events))
+	require.Len(t, events, 3)
+
+	wantPacket := Packet{
+		Sequence:           3,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+	}
+	require.Equal(t, PacketEvent{Kind: "WriteAcknowledgement", Packet: wantPacket, Height: ConsensusHeight{RevisionHeight: 1520}}, events[0])
+	require.Equal(t, PacketEvent{Kind: "TimeoutPacket", Packet: wantPacket}, events[1])
+	require.Equal(t, PacketEvent{Kind: EventChainError, Message: "account sequence mismatch"}, events[2])
+	require.Equal(t, []uint64{3}, events.Sequences("WriteAcknowledgement"))
+	require.Empty(t, events.Sequences("SendPacket"))
+	require.EqualError(t, events.Err(), "chain error: account sequence mismatch")
+	require.NoError(t, events[:2].Err())
+}
+
+func TestFtTransfer(t *testing.T) {
+	// the fake hermes binary prints its args, one per line
+	binPath := filepath.Join(t.TempDir(), "hermes")
+	require.NoError(t, os.WriteFile(binPath, []byte("#!/bin/sh\nprintf '%s\\n' \"$@\"\n"), 0o755))
+	h := &Hermes{path: binPath}
+
+	var buf bytes.Buffer
+	err := h.FtTransfer(
+		context.Background(),
+		"spoke-1",
+		"hub-1",
+		"transfer",
+		"channel-0",
+		10,
+		"uatom",
+		90*time.Second+500*time.Millisecond,
+		WithConfigFile("config.toml"),
+		WithStdOut(&buf),
+		WithJSONOutput(),
+		WithFlags(Flags{FlagReceiver: "cosmos1receiver"}),
+	)
+	require.NoError(t, err)
+
+	args := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, []string{"--config=config.toml", "--json", "tx", "ft-transfer"}, args[:4])
+	require.ElementsMatch(t, []string{
+		"--dst-chain=spoke-1",
+		"--src-chain=hub-1",
+		"--src-port=transfer",
+		"--src-channel=channel-0",
+		"--amount=10",
+		"--denom=uatom",
+		"--timeout-seconds=90",
+		"--receiver=cosmos1receiver",
+	}, args[4:])
+
+	err = h.FtTransfer(context.Background(), "spoke-1", "hub-1", "transfer", "channel-0", 10, "uatom", 0)
+	require.EqualError(t, err, "invalid transfer packet timeout 0s, must be at least 1s")
+}
@@ -0,0 +1,98 @@
+package hermes
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// KeyStoreTypeTest is the Hermes file keyring type.
+	KeyStoreTypeTest = "Test"
+
+	// addressTypeCosmos is the Hermes address type of the cosmos secp256k1 keys.
+	addressTypeCosmos = "Cosmos"
+
+	keyStoreDirectory = ".hermes/keys"
+	keyringTestDir    = "keyring-test"
+)
+
+// KeyPair represents a secp256k1 key pair in the Hermes keyring file format.
+type KeyPair struct {
+	PrivateKey  string   `json:"private_key"`
+	PublicKey   string   `json:"public_key"`
+	Address     [20]byte `json:"address"`
+	AddressType string   `json:"address_type"`
+	Account     string   `json:"account"`
+}
+
+// NewKeyPair creates a Hermes key pair from a secp256k1 private key.
+func NewKeyPair(privKey []byte, accountPrefix string) (KeyPair, error) {
+	if len(privKey) != secp256k1.PrivKeySize {
+		return KeyPair{}, errors.Errorf("invalid secp256k1 private key size %d", len(privKey))
+	}
+
+	var (
+		key     = secp256k1.PrivKey{Key: privKey}
+		pubKey  = key.PubKey()
+		address [20]byte
+	)
+	copy(address[:], pubKey.Address())
+
+	account, err := bech32.ConvertAndEncode(accountPrefix, address[:])
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return KeyPair{
+		PrivateKey:  hex.EncodeToString(privKey),
+		PublicKey:   hex.EncodeToString(pubKey.Bytes()),
+		Address:     address,
+		AddressType: addressTypeCosmos,
+		Account:     account,
+	}, nil
+}
+
+// KeyFilePath returns the path of the chain key into the Hermes keyring.
+func (c Chain) KeyFilePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, keyStoreDirectory, c.ID, keyringTestDir, c.KeyName+".json"), nil
+}
+
+// ImportKey writes the secp256k1 private key into the Hermes keyring as the chain key.
+// Hermes only accepts mnemonics from the CLI, so the key is written straight into the
+// keyring file, what only works for the Test key store type and cosmos addresses.
+func (c Chain) ImportKey(privKey []byte) (KeyPair, error) {
+	if c.KeyStoreType != "" && c.KeyStoreType != KeyStoreTypeTest {
+		return KeyPair{}, errors.Errorf("chain %s key store type %s is not supported, use %s", c.ID, c.KeyStoreType, KeyStoreTypeTest)
+	}
+	if !strings.EqualFold(c.AddressType.Derivation, addressTypeCosmos) {
+		return KeyPair{}, errors.Errorf("chain %s address type %s is not supported", c.ID, c.AddressType.Derivation)
+	}
+
+	keyPair, err := NewKeyPair(privKey, c.AccountPrefix)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	keyPath, err := c.KeyFilePath()
+	if err != nil {
+		return KeyPair{}, err
+	}
+	data, err := json.MarshalIndent(keyPair, "", "  ")
+	if err != nil {
+		return KeyPair{}, err
+	}
+	if err := os.MkdirAll(filepath.Dir(keyPath), 0o700); err != nil {
+		return KeyPair{}, err
+	}
+	return keyPair, os.WriteFile(keyPath, data, 0o600)
+}
@@ -0,0 +1,59 @@
+package hermes
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPrivKey = "0101010101010101010101010101010101010101010101010101010101010101"
+
+func TestNewKeyPair(t *testing.T) {
+	privKey, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+
+	keyPair, err := NewKeyPair(privKey, "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, testPrivKey, keyPair.PrivateKey)
+	require.Equal(t, "031b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f", keyPair.PublicKey)
+	require.Equal(t, "cosmos10xcqpzrky6eff2g52qdye53xkk9jxkvrpq6uqr", keyPair.Account)
+	require.Equal(t, "Cosmos", keyPair.AddressType)
+
+	_, err = NewKeyPair(privKey[:16], "cosmos")
+	require.EqualError(t, err, "invalid secp256k1 private key size 16")
+}
+
+func TestChainImportKey(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	privKey, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+
+	chain := Chain{
+		ID:            "hub-1",
+		AccountPrefix: "hub",
+		KeyName:       "wallet",
+		KeyStoreType:  KeyStoreTypeTest,
+		AddressType:   AddressType{Derivation: "cosmos"},
+	}
+	keyPair, err := chain.ImportKey(privKey)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(home, ".hermes/keys/hub-1/keyring-test/wallet.json"))
+	require.NoError(t, err)
+
+	// the address is stored as a byte array by Hermes
+	var stored map[string]any
+	require.NoError(t, json.Unmarshal(data, &stored))
+	require.Equal(t, keyPair.Account, stored["account"])
+	require.Len(t, stored["address"], 20)
+
+	chain.AddressType = AddressType{Derivation: "ethermint"}
+	_, err = chain.ImportKey(privKey)
+	require.EqualError(t, err, "chain hub-1 address type ethermint is not supported")
+}
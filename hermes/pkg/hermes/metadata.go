@@ -0,0 +1,269 @@
+package hermes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// defaultGasPrice is the gas price used with the bond denom when the chain has no minimum gas price.
+var defaultGasPrice = sdkmath.LegacyMustNewDecFromStr("0.025")
+
+type (
+	// ChainMetadata represents the chain settings derived from the chain itself, from
+	// its chain registry entry or from its Ignite config.
+	ChainMetadata struct {
+		AccountPrefix  string
+		GasPrice       sdk.DecCoin
+		TrustingPeriod string
+		EventSourceURL string
+	}
+
+	// chainRegistryEntry represents the fields used from a cosmos chain registry chain.json.
+	chainRegistryEntry struct {
+		ChainID      string `json:"chain_id"`
+		Bech32Prefix string `json:"bech32_prefix"`
+		Fees         struct {
+			FeeTokens []struct {
+				Denom            string  `json:"denom"`
+				FixedMinGasPrice float64 `json:"fixed_min_gas_price"`
+				LowGasPrice      float64 `json:"low_gas_price"`
+				AverageGasPrice  float64 `json:"average_gas_price"`
+			} `json:"fee_tokens"`
+		} `json:"fees"`
+	}
+
+	// igniteChainConfig represents the fields used from an Ignite chain config.yml.
+	igniteChainConfig struct {
+		Validators []struct {
+			App map[string]any `yaml:"app"`
+		} `yaml:"validators"`
+		Genesis struct {
+			ChainID  string `yaml:"chain_id"`
+			AppState struct {
+				Staking struct {
+					Params struct {
+						BondDenom     string `yaml:"bond_denom"`
+						UnbondingTime string `yaml:"unbonding_time"`
+					} `yaml:"params"`
+				} `yaml:"staking"`
+			} `yaml:"app_state"`
+		} `yaml:"genesis"`
+	}
+)
+
+// QueryChainMetadata queries the chain bech32 prefix, minimum gas price and unbonding time to
+// derive its Hermes settings. The trusting period is set to 2/3 of the unbonding time and the
+// gas price defaults to the bond denom when the node has no minimum gas price. The settings
+// of the successful queries are returned, even if some of the queries fail.
+func QueryChainMetadata(ctx context.Context, rpcAddr string) (ChainMetadata, error) {
+	metadata := ChainMetadata{}
+	eventSourceURL, err := EventSourceURL(rpcAddr)
+	if err != nil {
+		return metadata, err
+	}
+	metadata.EventSourceURL = eventSourceURL
+
+	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(rpcAddr))
+	if err != nil {
+		return metadata, err
+	}
+	clientCtx := client.Context()
+
+	var errs []error
+	prefix, err := authtypes.NewQueryClient(clientCtx).Bech32Prefix(ctx, &authtypes.Bech32PrefixRequest{})
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to query the bech32 prefix"))
+	} else {
+		metadata.AccountPrefix = prefix.Bech32Prefix
+	}
+
+	var bondDenom string
+	params, err := stakingtypes.NewQueryClient(clientCtx).Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to query the staking params"))
+	} else {
+		bondDenom = params.Params.BondDenom
+		metadata.TrustingPeriod = formatDuration(params.Params.UnbondingTime * 2 / 3)
+	}
+
+	nodeConfig, err := nodeservice.NewServiceClient(clientCtx).Config(ctx, &nodeservice.ConfigRequest{})
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to query the node config"))
+	} else if gasPrices, err := sdk.ParseDecCoins(nodeConfig.MinimumGasPrice); err != nil {
+		errs = append(errs, errors.Wrapf(err, "invalid minimum gas price %s", nodeConfig.MinimumGasPrice))
+	} else if len(gasPrices) > 0 {
+		metadata.GasPrice = gasPrices[0]
+	}
+	if metadata.GasPrice.Denom == "" && bondDenom != "" {
+		metadata.GasPrice = sdk.NewDecCoinFromDec(bondDenom, defaultGasPrice)
+	}
+
+	return metadata, errors.Join(errs...)
+}
+
+// LoadChainRegistry loads the chain metadata from a cosmos chain registry chain.json file path or URL.
+func LoadChainRegistry(ctx context.Context, chainID, source string) (ChainMetadata, error) {
+	data, err := readSource(ctx, source)
+	if err != nil {
+		return ChainMetadata{}, errors.Wrapf(err, "failed to read chain registry entry %s", source)
+	}
+	return parseChainRegistry(chainID, data)
+}
+
+// parseChainRegistry parses a chain registry chain.json, the gas price is the average gas price
+// of the first fee token, or its low or fixed minimum gas price if not set.
+func parseChainRegistry(chainID string, data []byte) (ChainMetadata, error) {
+	var entry chainRegistryEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return ChainMetadata{}, errors.Wrap(err, "invalid chain registry entry")
+	}
+	if entry.ChainID != "" && entry.ChainID != chainID {
+		return ChainMetadata{}, errors.Errorf("chain registry entry is for the chain %s, not %s", entry.ChainID, chainID)
+	}
+
+	metadata := ChainMetadata{AccountPrefix: entry.Bech32Prefix}
+	if len(entry.Fees.FeeTokens) > 0 {
+		token := entry.Fees.FeeTokens[0]
+		price := token.AverageGasPrice
+		if price == 0 {
+			price = token.LowGasPrice
+		}
+		if price == 0 {
+			price = token.FixedMinGasPrice
+		}
+		gasPrice, err := sdkmath.LegacyNewDecFromStr(strconv.FormatFloat(price, 'f', -1, 64))
+		if err != nil {
+			return ChainMetadata{}, errors.Wrapf(err, "invalid chain registry gas price %v", price)
+		}
+		metadata.GasPrice = sdk.NewDecCoinFromDec(token.Denom, gasPrice)
+	}
+	return metadata, nil
+}
+
+// LoadIgniteConfig loads the chain metadata from the config.yml file of a chain scaffolded with Ignite.
+func LoadIgniteConfig(chainID, configPath string) (ChainMetadata, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return ChainMetadata{}, errors.Wrapf(err, "failed to read Ignite config %s", configPath)
+	}
+	return parseIgniteConfig(chainID, data)
+}
+
+// parseIgniteConfig parses an Ignite chain config.yml. The gas price is the minimum gas price of
+// the first validator, or 0.025 of the genesis bond denom if not set, and the trusting period is
+// 2/3 of the genesis unbonding time. The account prefix isn't part of the Ignite config.
+func parseIgniteConfig(chainID string, data []byte) (ChainMetadata, error) {
+	var cfg igniteChainConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return ChainMetadata{}, errors.Wrap(err, "invalid Ignite config")
+	}
+	if cfg.Genesis.ChainID != "" && cfg.Genesis.ChainID != chainID {
+		return ChainMetadata{}, errors.Errorf("Ignite config is for the chain %s, not %s", cfg.Genesis.ChainID, chainID)
+	}
+
+	var (
+		metadata ChainMetadata
+		params   = cfg.Genesis.AppState.Staking.Params
+	)
+	if len(cfg.Validators) > 0 {
+		if minGasPrices, ok := cfg.Validators[0].App["minimum-gas-prices"].(string); ok {
+			gasPrices, err := sdk.ParseDecCoins(minGasPrices)
+			if err != nil {
+				return ChainMetadata{}, errors.Wrapf(err, "invalid Ignite config minimum gas price %s", minGasPrices)
+			}
+			if len(gasPrices) > 0 {
+				metadata.GasPrice = gasPrices[0]
+			}
+		}
+	}
+	if metadata.GasPrice.Denom == "" && params.BondDenom != "" {
+		metadata.GasPrice = sdk.NewDecCoinFromDec(params.BondDenom, defaultGasPrice)
+	}
+	if params.UnbondingTime != "" {
+		unbondingTime, err := time.ParseDuration(params.UnbondingTime)
+		if err != nil {
+			return ChainMetadata{}, errors.Wrapf(err, "invalid Ignite config unbonding time %s", params.UnbondingTime)
+		}
+		metadata.TrustingPeriod = formatDuration(unbondingTime * 2 / 3)
+	}
+	return metadata, nil
+}
+
+// Merge returns the metadata with the empty settings filled from the other metadata.
+func (m ChainMetadata) Merge(other ChainMetadata) ChainMetadata {
+	if m.AccountPrefix == "" {
+		m.AccountPrefix = other.AccountPrefix
+	}
+	if m.GasPrice.Denom == "" {
+		m.GasPrice = other.GasPrice
+	}
+	if m.TrustingPeriod == "" {
+		m.TrustingPeriod = other.TrustingPeriod
+	}
+	if m.EventSourceURL == "" {
+		m.EventSourceURL = other.EventSourceURL
+	}
+	return m
+}
+
+// EventSourceURL returns the websocket event source URL of the RPC address,
+// using a secure websocket for HTTPS addresses.
+func EventSourceURL(rpcAddr string) (string, error) {
+	rpcURL, err := url.Parse(rpcAddr)
+	if err != nil {
+		return "", err
+	}
+	scheme := "ws"
+	if rpcURL.Scheme == "https" {
+		scheme = "wss"
+	}
+	return fmt.Sprintf("%s://%s/websocket", scheme, rpcURL.Host), nil
+}
+
+// formatDuration formats a duration in the Hermes format, using whole hours when possible.
+func formatDuration(d time.Duration) string {
+	if d%time.Hour == 0 {
+		return fmt.Sprintf("%dh", d/time.Hour)
+	}
+	return fmt.Sprintf("%ds", d/time.Second)
+}
+
+// readSource reads a file path or an HTTP URL.
+func readSource(ctx context.Context, source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.ReadFile(source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,106 @@
+package hermes
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseDecCoin(t *testing.T, coin string) sdk.DecCoin {
+	t.Helper()
+	decCoin, err := sdk.ParseDecCoin(coin)
+	require.NoError(t, err)
+	return decCoin
+}
+
+func TestParseChainRegistry(t *testing.T) {
+	entry := `{
+  "chain_name": "cosmoshub",
+  "chain_id": "cosmoshub-4",
+  "bech32_prefix": "cosmos",
+  "fees": {
+    "fee_tokens": [
+      {"denom": "uatom", "fixed_min_gas_price": 0.005, "low_gas_price": 0.01, "average_gas_price": 0.025}
+    ]
+  }
+}`
+
+	metadata, err := parseChainRegistry("cosmoshub-4", []byte(entry))
+	require.NoError(t, err)
+	require.Equal(t, "cosmos", metadata.AccountPrefix)
+	require.Equal(t, mustParseDecCoin(t, "0.025uatom"), metadata.GasPrice)
+
+	metadata, err = parseChainRegistry("cosmoshub-4", []byte(`{"fees":{"fee_tokens":[{"denom":"uatom","fixed_min_gas_price":0.00001}]}}`))
+	require.NoError(t, err)
+	require.Equal(t, mustParseDecCoin(t, "0.00001uatom"), metadata.GasPrice)
+
+	_, err = parseChainRegistry("hub-1", []byte(entry))
+	require.EqualError(t, err, "chain registry entry is for the chain cosmoshub-4, not hub-1")
+}
+
+func TestChainMetadataMerge(t *testing.T) {
+	registry := ChainMetadata{AccountPrefix: "hub", GasPrice: mustParseDecCoin(t, "0.1uhub")}
+	queried := ChainMetadata{
+		AccountPrefix:  "cosmos",
+		GasPrice:       mustParseDecCoin(t, "0.025stake"),
+		TrustingPeriod: "336h",
+		EventSourceURL: "ws://localhost:26657/websocket",
+	}
+	require.Equal(t, ChainMetadata{
+		AccountPrefix:  "hub",
+		GasPrice:       mustParseDecCoin(t, "0.1uhub"),
+		TrustingPeriod: "336h",
+		EventSourceURL: "ws://localhost:26657/websocket",
+	}, registry.Merge(queried))
+}
+
+func TestEventSourceURL(t *testing.T) {
+	got, err := EventSourceURL("http://localhost:26657")
+	require.NoError(t, err)
+	require.Equal(t, "ws://localhost:26657/websocket", got)
+
+	got, err = EventSourceURL("https://rpc.cosmos.network:443")
+	require.NoError(t, err)
+	require.Equal(t, "wss://rpc.cosmos.network:443/websocket", got)
+}
+
+func TestFormatDuration(t *testing.T) {
+	require.Equal(t, "336h", formatDuration(21*24*time.Hour*2/3))
+	require.Equal(t, "80s", formatDuration(2*time.Minute*2/3))
+}
+
+func TestParseIgniteConfig(t *testing.T) {
+	config := `version: 1
+accounts:
+  - name: alice
+    coins: [20000token, 200000000stake]
+validators:
+  - name: alice
+    bonded: 100000000stake
+    app:
+      minimum-gas-prices: 0.01uhub
+genesis:
+  chain_id: hub-1
+  app_state:
+    staking:
+      params:
+        bond_denom: uhub
+        unbonding_time: 1814400s
+`
+
+	metadata, err := parseIgniteConfig("hub-1", []byte(config))
+	require.NoError(t, err)
+	require.Equal(t, ChainMetadata{
+		GasPrice:       mustParseDecCoin(t, "0.01uhub"),
+		TrustingPeriod: "336h",
+	}, metadata)
+
+	metadata, err = parseIgniteConfig("hub-1", []byte("genesis:\n  app_state:\n    staking:\n      params:\n        bond_denom: uhub\n"))
+	require.NoError(t, err)
+	require.Equal(t, ChainMetadata{GasPrice: mustParseDecCoin(t, "0.025uhub")}, metadata)
+
+	_, err = parseIgniteConfig("spoke-1", []byte(config))
+	require.EqualError(t, err, "Ignite config is for the chain hub-1, not spoke-1")
+}
@@ -0,0 +1,129 @@
+package hermes
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// pathChainSeparator separates the two chains of a path.
+	pathChainSeparator = ":"
+	// pathPortSeparator separates the chain id and the port id of a path end.
+	pathPortSeparator = "/"
+)
+
+type (
+	// Path represents an IBC path relayed between two chains of the Hermes config.
+	Path struct {
+		ChainA          string
+		PortA           string
+		ChainB          string
+		PortB           string
+		Ordering        string
+		Version         string
+		ConnectionDelay time.Duration
+	}
+
+	// Paths represents a list of paths.
+	Paths []Path
+)
+
+// ParsePath parses a path in the <chain-a-id>[/<port-id>]:<chain-b-id>[/<port-id>] format.
+// The default port ids are used if the path doesn't specify them.
+func ParsePath(path, defaultPortA, defaultPortB string) (Path, error) {
+	chainA, chainB, ok := strings.Cut(path, pathChainSeparator)
+	if !ok {
+		return Path{}, errors.Errorf(
+			"invalid path %s, expected format <chain-a-id>[/<port-id>]:<chain-b-id>[/<port-id>]",
+			path,
+		)
+	}
+
+	var p Path
+	p.ChainA, p.PortA = parsePathEnd(chainA, defaultPortA)
+	p.ChainB, p.PortB = parsePathEnd(chainB, defaultPortB)
+	if p.ChainA == "" || p.ChainB == "" {
+		return Path{}, errors.Errorf("invalid path %s, both chain ids must be set", path)
+	}
+	if p.PortA == "" || p.PortB == "" {
+		return Path{}, errors.Errorf("invalid path %s, both port ids must be set", path)
+	}
+	if p.ChainA == p.ChainB {
+		return Path{}, errors.Errorf("invalid path %s, a chain cannot be relayed with itself", path)
+	}
+	return p, nil
+}
+
+// parsePathEnd parses a path end in the <chain-id>[/<port-id>] format.
+func parsePathEnd(end, defaultPort string) (chainID, portID string) {
+	chainID, portID, ok := strings.Cut(strings.TrimSpace(end), pathPortSeparator)
+	if !ok {
+		portID = defaultPort
+	}
+	return strings.TrimSpace(chainID), strings.TrimSpace(portID)
+}
+
+// String implements fmt.Stringer.
+func (p Path) String() string {
+	return fmt.Sprintf(
+		"%s%s%s%s%s%s%s",
+		p.ChainA,
+		pathPortSeparator,
+		p.PortA,
+		pathChainSeparator,
+		p.ChainB,
+		pathPortSeparator,
+		p.PortB,
+	)
+}
+
+// ValidatePaths checks the paths only relay chains from the config.
+func (c *Config) ValidatePaths(paths Paths) error {
+	if len(paths) == 0 {
+		return errors.New("at least one path must be relayed")
+	}
+	seen := make(map[string]struct{})
+	for _, p := range paths {
+		if _, ok := seen[p.String()]; ok {
+			return errors.Errorf("duplicated path %s", p)
+		}
+		seen[p.String()] = struct{}{}
+
+		if _, err := c.Chains.Get(p.ChainA); err != nil {
+			return errors.Wrapf(err, "invalid path %s", p)
+		}
+		if _, err := c.Chains.Get(p.ChainB); err != nil {
+			return errors.Wrapf(err, "invalid path %s", p)
+		}
+	}
+	return nil
+}
+
+// HubPaths returns the paths relaying the hub chain with each one of the other chains.
+func HubPaths(hub string, chainIDs []string, hubPort, port string) Paths {
+	paths := make(Paths, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		if chainID == hub {
+			continue
+		}
+		paths = append(paths, Path{
+			ChainA: hub,
+			PortA:  hubPort,
+			ChainB: chainID,
+			PortB:  port,
+		})
+	}
+	return paths
+}
+
+// ChainIDs returns the chain ids from the config.
+func (c Chains) ChainIDs() []string {
+	ids := make([]string, 0, len(c))
+	for _, chain := range c {
+		ids = append(ids, chain.ID)
+	}
+	return ids
+}
@@ -0,0 +1,119 @@
+package hermes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want Path
+		err  string
+	}{
+		{
+			name: "default ports",
+			path: "mars-1:venus-1",
+			want: Path{ChainA: "mars-1", PortA: "transfer", ChainB: "venus-1", PortB: "transfer"},
+		},
+		{
+			name: "custom ports",
+			path: "mars-1/icacontroller:venus-1/icahost",
+			want: Path{ChainA: "mars-1", PortA: "icacontroller", ChainB: "venus-1", PortB: "icahost"},
+		},
+		{
+			name: "custom port on one side",
+			path: "mars-1:venus-1/consumer",
+			want: Path{ChainA: "mars-1", PortA: "transfer", ChainB: "venus-1", PortB: "consumer"},
+		},
+		{
+			name: "missing separator",
+			path: "mars-1",
+			err:  "invalid path mars-1, expected format <chain-a-id>[/<port-id>]:<chain-b-id>[/<port-id>]",
+		},
+		{
+			name: "missing chain",
+			path: "mars-1:",
+			err:  "invalid path mars-1:, both chain ids must be set",
+		},
+		{
+			name: "empty port",
+			path: "mars-1/:venus-1",
+			err:  "invalid path mars-1/:venus-1, both port ids must be set",
+		},
+		{
+			name: "same chain",
+			path: "mars-1:mars-1",
+			err:  "invalid path mars-1:mars-1, a chain cannot be relayed with itself",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePath(tt.path, "transfer", "transfer")
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfigValidatePaths(t *testing.T) {
+	cfg := DefaultConfig()
+	for _, chainID := range []string{"hub-1", "spoke-1", "spoke-2"} {
+		_, err := cfg.AddChain(chainID, "http://localhost:26657", "http://localhost:9090")
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name  string
+		paths Paths
+		err   string
+	}{
+		{
+			name:  "hub paths",
+			paths: HubPaths("hub-1", cfg.Chains.ChainIDs(), "transfer", "transfer"),
+		},
+		{
+			name: "no paths",
+			err:  "at least one path must be relayed",
+		},
+		{
+			name: "unknown chain",
+			paths: Paths{
+				{ChainA: "hub-1", PortA: "transfer", ChainB: "spoke-3", PortB: "transfer"},
+			},
+			err: "invalid path hub-1/transfer:spoke-3/transfer: chain spoke-3 not exist",
+		},
+		{
+			name: "duplicated path",
+			paths: Paths{
+				{ChainA: "hub-1", PortA: "transfer", ChainB: "spoke-1", PortB: "transfer"},
+				{ChainA: "hub-1", PortA: "transfer", ChainB: "spoke-1", PortB: "transfer"},
+			},
+			err: "duplicated path hub-1/transfer:spoke-1/transfer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cfg.ValidatePaths(tt.paths)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHubPaths(t *testing.T) {
+	got := HubPaths("hub-1", []string{"hub-1", "spoke-1", "spoke-2"}, "transfer", "consumer")
+	require.Equal(t, Paths{
+		{ChainA: "hub-1", PortA: "transfer", ChainB: "spoke-1", PortB: "consumer"},
+		{ChainA: "hub-1", PortA: "transfer", ChainB: "spoke-2", PortB: "consumer"},
+	}, got)
+}
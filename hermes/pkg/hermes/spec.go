@@ -0,0 +1,275 @@
+package hermes
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// OrderingOrdered is the ordered channel ordering.
+	OrderingOrdered = "ordered"
+	// OrderingUnordered is the unordered channel ordering.
+	OrderingUnordered = "unordered"
+
+	// DefaultPortID is the default port id used by the paths.
+	DefaultPortID = "transfer"
+	// DefaultKeyringBackend is the default keyring backend of the imported accounts.
+	DefaultKeyringBackend = "test"
+)
+
+type (
+	// Spec represents a declarative relayer spec describing the chains and the paths to be relayed.
+	Spec struct {
+		Chains []SpecChain `yaml:"chains" toml:"chains"`
+		Paths  []SpecPath  `yaml:"paths" toml:"paths"`
+	}
+
+	// SpecChain represents a chain from the relayer spec.
+	SpecChain struct {
+		ID               string  `yaml:"id" toml:"id"`
+		RPCAddr          string  `yaml:"rpc_addr" toml:"rpc_addr"`
+		GRPCAddr         string  `yaml:"grpc_addr" toml:"grpc_addr"`
+		EventSourceURL   string  `yaml:"event_source_url" toml:"event_source_url"`
+		Type             string  `yaml:"type" toml:"type"`
+		CCVConsumerChain bool    `yaml:"ccv_consumer_chain" toml:"ccv_consumer_chain"`
+		AccountPrefix    string  `yaml:"account_prefix" toml:"account_prefix"`
+		KeyName          string  `yaml:"key_name" toml:"key_name"`
+		GasPrice         string  `yaml:"gas_price" toml:"gas_price"`
+		GasMultiplier    float64 `yaml:"gas_multiplier" toml:"gas_multiplier"`
+		DefaultGas       uint64  `yaml:"default_gas" toml:"default_gas"`
+		MaxGas           uint64  `yaml:"max_gas" toml:"max_gas"`
+		ClockDrift       string  `yaml:"clock_drift" toml:"clock_drift"`
+		MaxBlockTime     string  `yaml:"max_block_time" toml:"max_block_time"`
+		TrustingPeriod   string  `yaml:"trusting_period" toml:"trusting_period"`
+		TrustThreshold   string  `yaml:"trust_threshold" toml:"trust_threshold"`
+		MemoPrefix       string  `yaml:"memo_prefix" toml:"memo_prefix"`
+		Faucet           string  `yaml:"faucet" toml:"faucet"`
+		Key              SpecKey `yaml:"key" toml:"key"`
+	}
+
+	// SpecKey represents the relayer key of a chain from the relayer spec. The key can also
+	// be imported from an Ignite account or a chain keyring key by name.
+	SpecKey struct {
+		Mnemonic       string `yaml:"mnemonic" toml:"mnemonic"`
+		MnemonicFile   string `yaml:"mnemonic_file" toml:"mnemonic_file"`
+		Account        string `yaml:"account" toml:"account"`
+		KeyringBackend string `yaml:"keyring_backend" toml:"keyring_backend"`
+		KeyringDir     string `yaml:"keyring_dir" toml:"keyring_dir"`
+	}
+
+	// SpecPath represents a path from the relayer spec.
+	SpecPath struct {
+		ChainA          string `yaml:"chain_a" toml:"chain_a"`
+		PortA           string `yaml:"port_a" toml:"port_a"`
+		ChainB          string `yaml:"chain_b" toml:"chain_b"`
+		PortB           string `yaml:"port_b" toml:"port_b"`
+		Ordering        string `yaml:"ordering" toml:"ordering"`
+		Version         string `yaml:"version" toml:"version"`
+		ConnectionDelay string `yaml:"connection_delay" toml:"connection_delay"`
+	}
+)
+
+// sources returns the number of key sources set.
+func (k SpecKey) sources() int {
+	n := 0
+	for _, source := range []string{k.Mnemonic, k.MnemonicFile, k.Account} {
+		if source != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Backend returns the keyring backend of the key account, the test backend by default.
+func (k SpecKey) Backend() string {
+	if k.KeyringBackend == "" {
+		return DefaultKeyringBackend
+	}
+	return k.KeyringBackend
+}
+
+// LoadSpec loads a relayer spec from a YAML or TOML file.
+func LoadSpec(specPath string) (*Spec, error) {
+	specBytes, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+	switch strings.ToLower(filepath.Ext(specPath)) {
+	case ".toml":
+		err = toml.Unmarshal(specBytes, spec)
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(specBytes, spec)
+	default:
+		return nil, errors.Errorf("unsupported relayer spec file %s, use a YAML or TOML file", specPath)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode relayer spec %s", specPath)
+	}
+	return spec, spec.Validate()
+}
+
+// Validate validates the relayer spec.
+func (s Spec) Validate() error {
+	if len(s.Chains) < 2 {
+		return errors.New("the relayer spec must have at least two chains")
+	}
+
+	chainIDs := make(map[string]struct{})
+	for _, chain := range s.Chains {
+		switch {
+		case chain.ID == "":
+			return errors.New("chain id cannot be empty")
+		case chain.RPCAddr == "":
+			return errors.Errorf("chain %s rpc address cannot be empty", chain.ID)
+		case chain.GRPCAddr == "":
+			return errors.Errorf("chain %s grpc address cannot be empty", chain.ID)
+		case chain.Key.sources() > 1:
+			return errors.Errorf("chain %s key must have either a mnemonic, a mnemonic file or an account", chain.ID)
+		}
+		if _, ok := chainIDs[chain.ID]; ok {
+			return errors.Errorf("duplicated chain %s", chain.ID)
+		}
+		chainIDs[chain.ID] = struct{}{}
+	}
+
+	for _, path := range s.Paths {
+		if _, ok := chainIDs[path.ChainA]; !ok {
+			return errors.Errorf("path chain %s is not declared", path.ChainA)
+		}
+		if _, ok := chainIDs[path.ChainB]; !ok {
+			return errors.Errorf("path chain %s is not declared", path.ChainB)
+		}
+		switch strings.ToLower(path.Ordering) {
+		case "", OrderingOrdered, OrderingUnordered:
+		default:
+			return errors.Errorf(
+				"invalid path ordering %s, valid options are %s and %s",
+				path.Ordering,
+				OrderingOrdered,
+				OrderingUnordered,
+			)
+		}
+		if path.ConnectionDelay != "" {
+			if _, err := time.ParseDuration(path.ConnectionDelay); err != nil {
+				return errors.Wrapf(err, "invalid path connection delay %s", path.ConnectionDelay)
+			}
+		}
+	}
+	return nil
+}
+
+// Config creates a Hermes config with the spec chains.
+func (s Spec) Config(options ...ConfigOption) (*Config, error) {
+	c := DefaultConfig(options...)
+	for _, chain := range s.Chains {
+		chainOptions, err := chain.options()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.AddChain(chain.ID, chain.RPCAddr, chain.GRPCAddr, chainOptions...); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// RelayPaths returns the paths to be relayed. If the spec has no paths,
+// the first chain is relayed with each one of the other chains.
+func (s Spec) RelayPaths() (Paths, error) {
+	if len(s.Paths) == 0 {
+		chainIDs := make([]string, 0, len(s.Chains))
+		for _, chain := range s.Chains {
+			chainIDs = append(chainIDs, chain.ID)
+		}
+		return HubPaths(chainIDs[0], chainIDs, DefaultPortID, DefaultPortID), nil
+	}
+
+	paths := make(Paths, 0, len(s.Paths))
+	for _, p := range s.Paths {
+		path := Path{
+			ChainA:   p.ChainA,
+			PortA:    p.PortA,
+			ChainB:   p.ChainB,
+			PortB:    p.PortB,
+			Ordering: strings.ToLower(p.Ordering),
+			Version:  p.Version,
+		}
+		if path.PortA == "" {
+			path.PortA = DefaultPortID
+		}
+		if path.PortB == "" {
+			path.PortB = DefaultPortID
+		}
+		if p.ConnectionDelay != "" {
+			delay, err := time.ParseDuration(p.ConnectionDelay)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid path connection delay %s", p.ConnectionDelay)
+			}
+			path.ConnectionDelay = delay
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// options returns the Hermes chain options from the spec chain.
+func (c SpecChain) options() ([]ChainOption, error) {
+	options := []ChainOption{
+		WithChainCCVConsumerChain(c.CCVConsumerChain),
+	}
+	if c.EventSourceURL != "" {
+		options = append(options, WithChainEventSource("push", c.EventSourceURL, "500ms"))
+	}
+	if c.Type != "" {
+		options = append(options, WithChainType(c.Type))
+	}
+	if c.AccountPrefix != "" {
+		options = append(options, WithChainAccountPrefix(c.AccountPrefix))
+	}
+	if c.KeyName != "" {
+		options = append(options, WithChainKeyName(c.KeyName))
+	}
+	if c.GasPrice != "" {
+		gasPrice, err := sdk.ParseDecCoin(c.GasPrice)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid chain %s gas price", c.ID)
+		}
+		options = append(options, WithChainGasPrice(gasPrice))
+	}
+	if c.GasMultiplier > 0 {
+		options = append(options, WithChainGasMultiplier(big.NewFloat(c.GasMultiplier)))
+	}
+	if c.DefaultGas > 0 {
+		options = append(options, WithChainDefaultGas(c.DefaultGas))
+	}
+	if c.MaxGas > 0 {
+		options = append(options, WithChainMaxGas(c.MaxGas))
+	}
+	if c.ClockDrift != "" {
+		options = append(options, WithChainClockDrift(c.ClockDrift))
+	}
+	if c.MaxBlockTime != "" {
+		options = append(options, WithChainMaxBlockTime(c.MaxBlockTime))
+	}
+	if c.TrustingPeriod != "" {
+		options = append(options, WithChainTrustingPeriod(c.TrustingPeriod))
+	}
+	if c.TrustThreshold != "" {
+		options = append(options, WithChainTrustThreshold(c.TrustThreshold))
+	}
+	if c.MemoPrefix != "" {
+		options = append(options, WithChainMemoPrefix(c.MemoPrefix))
+	}
+	return options, nil
+}
@@ -0,0 +1,195 @@
+package hermes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	specYAML = `chains:
+  - id: hub-1
+    rpc_addr: http://localhost:26657
+    grpc_addr: http://localhost:9090
+    account_prefix: hub
+    gas_price: 0.025uhub
+    faucet: http://localhost:4500
+    key:
+      mnemonic: "great immense still pill"
+  - id: spoke-1
+    rpc_addr: http://localhost:26667
+    grpc_addr: http://localhost:9100
+    key:
+      account: bob
+      keyring_dir: ~/.spoke
+paths:
+  - chain_a: hub-1
+    chain_b: spoke-1
+    port_a: icacontroller
+    port_b: icahost
+    ordering: Ordered
+    version: ics27-1
+    connection_delay: 30s
+`
+	specTOML = `[[chains]]
+id = "hub-1"
+rpc_addr = "http://localhost:26657"
+grpc_addr = "http://localhost:9090"
+
+[[chains]]
+id = "spoke-1"
+rpc_addr = "http://localhost:26667"
+grpc_addr = "http://localhost:9100"
+
+[[chains]]
+id = "spoke-2"
+rpc_addr = "http://localhost:26677"
+grpc_addr = "http://localhost:9110"
+`
+)
+
+func writeSpec(t *testing.T, name, content string) string {
+	t.Helper()
+	specPath := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(specPath, []byte(content), 0o644))
+	return specPath
+}
+
+func TestLoadSpec(t *testing.T) {
+	t.Run("yaml spec", func(t *testing.T) {
+		spec, err := LoadSpec(writeSpec(t, "paths.yml", specYAML))
+		require.NoError(t, err)
+		require.Len(t, spec.Chains, 2)
+		require.Equal(t, "http://localhost:4500", spec.Chains[0].Faucet)
+		require.Equal(t, "great immense still pill", spec.Chains[0].Key.Mnemonic)
+		require.Equal(t, "bob", spec.Chains[1].Key.Account)
+		require.Equal(t, DefaultKeyringBackend, spec.Chains[1].Key.Backend())
+
+		paths, err := spec.RelayPaths()
+		require.NoError(t, err)
+		require.Equal(t, Paths{{
+			ChainA:          "hub-1",
+			PortA:           "icacontroller",
+			ChainB:          "spoke-1",
+			PortB:           "icahost",
+			Ordering:        OrderingOrdered,
+			Version:         "ics27-1",
+			ConnectionDelay: 30 * time.Second,
+		}}, paths)
+
+		cfg, err := spec.Config()
+		require.NoError(t, err)
+		chain, err := cfg.Chains.Get("hub-1")
+		require.NoError(t, err)
+		require.Equal(t, "hub", chain.AccountPrefix)
+		require.Equal(t, GasPrice{Denom: "uhub", Price: 0.025}, chain.GasPrice)
+	})
+
+	t.Run("toml spec with default paths", func(t *testing.T) {
+		spec, err := LoadSpec(writeSpec(t, "paths.toml", specTOML))
+		require.NoError(t, err)
+
+		paths, err := spec.RelayPaths()
+		require.NoError(t, err)
+		require.Equal(t, Paths{
+			{ChainA: "hub-1", PortA: DefaultPortID, ChainB: "spoke-1", PortB: DefaultPortID},
+			{ChainA: "hub-1", PortA: DefaultPortID, ChainB: "spoke-2", PortB: DefaultPortID},
+		}, paths)
+	})
+
+	t.Run("unsupported file", func(t *testing.T) {
+		_, err := LoadSpec(writeSpec(t, "paths.json", "{}"))
+		require.ErrorContains(t, err, "unsupported relayer spec file")
+	})
+}
+
+func TestSpecValidate(t *testing.T) {
+	chains := []SpecChain{
+		{ID: "hub-1", RPCAddr: "http://localhost:26657", GRPCAddr: "http://localhost:9090"},
+		{ID: "spoke-1", RPCAddr: "http://localhost:26667", GRPCAddr: "http://localhost:9100"},
+	}
+	tests := []struct {
+		name string
+		spec Spec
+		err  string
+	}{
+		{
+			name: "valid spec",
+			spec: Spec{Chains: chains, Paths: []SpecPath{{ChainA: "hub-1", ChainB: "spoke-1"}}},
+		},
+		{
+			name: "single chain",
+			spec: Spec{Chains: chains[:1]},
+			err:  "the relayer spec must have at least two chains",
+		},
+		{
+			name: "duplicated chain",
+			spec: Spec{Chains: []SpecChain{chains[0], chains[0]}},
+			err:  "duplicated chain hub-1",
+		},
+		{
+			name: "missing rpc address",
+			spec: Spec{Chains: []SpecChain{chains[0], {ID: "spoke-1"}}},
+			err:  "chain spoke-1 rpc address cannot be empty",
+		},
+		{
+			name: "multiple key sources",
+			spec: Spec{Chains: []SpecChain{
+				chains[0],
+				{
+					ID:       "spoke-1",
+					RPCAddr:  "http://localhost:26667",
+					GRPCAddr: "http://localhost:9100",
+					Key:      SpecKey{MnemonicFile: "spoke.mnemonic", Account: "alice"},
+				},
+			}},
+			err: "chain spoke-1 key must have either a mnemonic, a mnemonic file or an account",
+		},
+		{
+			name: "undeclared path chain",
+			spec: Spec{Chains: chains, Paths: []SpecPath{{ChainA: "hub-1", ChainB: "spoke-2"}}},
+			err:  "path chain spoke-2 is not declared",
+		},
+		{
+			name: "invalid ordering",
+			spec: Spec{Chains: chains, Paths: []SpecPath{{ChainA: "hub-1", ChainB: "spoke-1", Ordering: "random"}}},
+			err:  "invalid path ordering random, valid options are ordered and unordered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Validate()
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChannelEndMatches(t *testing.T) {
+	channelEnd := ChannelEnd{
+		State:    "Open",
+		Ordering: "Unordered",
+		Remote:   ChannelCounterpart{PortID: "transfer", ChannelID: "channel-3"},
+		Version:  "ics20-1",
+	}
+	path := Path{ChainA: "hub-1", PortA: "transfer", ChainB: "spoke-1", PortB: "transfer"}
+
+	require.True(t, channelEnd.IsOpen())
+	require.True(t, channelEnd.Matches(path))
+
+	path.Version = "ics20-1"
+	require.True(t, channelEnd.Matches(path))
+
+	path.Ordering = OrderingOrdered
+	require.False(t, channelEnd.Matches(path))
+
+	path.Ordering = OrderingUnordered
+	path.PortB = "icahost"
+	require.False(t, channelEnd.Matches(path))
+}
@@ -0,0 +1,151 @@
+package hermes
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// StatusReport represents the relayer health status of a Hermes config.
+	StatusReport struct {
+		Config      string             `json:"config"`
+		Chains      []ChainStatus      `json:"chains"`
+		Clients     []ClientStatus     `json:"clients"`
+		Connections []ConnectionStatus `json:"connections"`
+		Channels    []ChannelStatus    `json:"channels"`
+		Errors      []string           `json:"errors,omitempty"`
+	}
+
+	// ChainStatus represents the relayer wallet status of a chain.
+	ChainStatus struct {
+		ChainID  string `json:"chain_id"`
+		Wallet   string `json:"wallet"`
+		Balances string `json:"balances"`
+	}
+
+	// ClientStatus represents the status of a client hosted by a chain.
+	ClientStatus struct {
+		ChainID             string    `json:"chain_id"`
+		ClientID            string    `json:"client_id"`
+		CounterpartyChainID string    `json:"counterparty_chain_id"`
+		Status              string    `json:"status"`
+		TrustingPeriod      string    `json:"trusting_period"`
+		LastUpdate          time.Time `json:"last_update"`
+		ExpiresAt           time.Time `json:"expires_at"`
+		Headroom            string    `json:"headroom"`
+		headroom            time.Duration
+	}
+
+	// ConnectionStatus represents the status of a connection between two chains.
+	ConnectionStatus struct {
+		ChainID                  string `json:"chain_id"`
+		ConnectionID             string `json:"connection_id"`
+		ClientID                 string `json:"client_id"`
+		CounterpartyChainID      string `json:"counterparty_chain_id"`
+		CounterpartyConnectionID string `json:"counterparty_connection_id"`
+		State                    string `json:"state"`
+	}
+
+	// ChannelStatus represents the status and the pending packets of a channel between two chains.
+	ChannelStatus struct {
+		ChainID               string               `json:"chain_id"`
+		PortID                string               `json:"port_id"`
+		ChannelID             string               `json:"channel_id"`
+		CounterpartyChainID   string               `json:"counterparty_chain_id"`
+		CounterpartyPortID    string               `json:"counterparty_port_id"`
+		CounterpartyChannelID string               `json:"counterparty_channel_id"`
+		State                 string               `json:"state"`
+		PendingPackets        PendingPacketsResult `json:"pending_packets"`
+	}
+)
+
+// NewClientStatus creates a client status, computing the client expiration
+// and the trusting period headroom from the last client update.
+func NewClientStatus(
+	chainID,
+	clientID,
+	counterpartyChainID,
+	status string,
+	trustingPeriod time.Duration,
+	lastUpdate,
+	now time.Time,
+) ClientStatus {
+	expiresAt := lastUpdate.Add(trustingPeriod)
+	headroom := expiresAt.Sub(now).Truncate(time.Second)
+	return ClientStatus{
+		ChainID:             chainID,
+		ClientID:            clientID,
+		CounterpartyChainID: counterpartyChainID,
+		Status:              status,
+		TrustingPeriod:      trustingPeriod.String(),
+		LastUpdate:          lastUpdate,
+		ExpiresAt:           expiresAt,
+		Headroom:            headroom.String(),
+		headroom:            headroom,
+	}
+}
+
+// IsExpired returns true if the client trusting period is over.
+func (c ClientStatus) IsExpired() bool {
+	return c.headroom <= 0
+}
+
+// Issues returns the issues preventing the relayer to relay packets.
+func (r StatusReport) Issues() []string {
+	issues := make([]string, 0)
+	for _, client := range r.Clients {
+		switch {
+		case client.Status != ClientStatusActive:
+			issues = append(issues, fmt.Sprintf(
+				"client %s on %s is %s",
+				client.ClientID,
+				client.ChainID,
+				client.Status,
+			))
+		case client.IsExpired():
+			issues = append(issues, fmt.Sprintf(
+				"client %s on %s trusting period expired at %s",
+				client.ClientID,
+				client.ChainID,
+				client.ExpiresAt.Format(time.RFC3339),
+			))
+		}
+	}
+	for _, connection := range r.Connections {
+		if connection.State != StateOpen {
+			issues = append(issues, fmt.Sprintf(
+				"connection %s on %s is %s",
+				connection.ConnectionID,
+				connection.ChainID,
+				connection.State,
+			))
+		}
+	}
+	for _, channel := range r.Channels {
+		if channel.State != StateOpen {
+			issues = append(issues, fmt.Sprintf(
+				"channel %s/%s on %s is %s",
+				channel.PortID,
+				channel.ChannelID,
+				channel.ChainID,
+				channel.State,
+			))
+		}
+		if total := channel.PendingPackets.Total(); total > 0 {
+			issues = append(issues, fmt.Sprintf(
+				"channel %s/%s on %s has %d pending packets and acknowledgments",
+				channel.PortID,
+				channel.ChannelID,
+				channel.ChainID,
+				total,
+			))
+		}
+	}
+	return append(issues, r.Errors...)
+}
+
+// JSON returns the report encoded as JSON.
+func (r StatusReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
@@ -0,0 +1,72 @@
+package hermes
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientStatus(t *testing.T) {
+	var (
+		now            = time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+		trustingPeriod = 14 * 24 * time.Hour
+	)
+
+	client := NewClientStatus("hub-1", "07-tendermint-0", "spoke-1", ClientStatusActive, trustingPeriod, now.Add(-24*time.Hour), now)
+	require.Equal(t, now.Add(13*24*time.Hour), client.ExpiresAt)
+	require.Equal(t, "312h0m0s", client.Headroom)
+	require.Equal(t, "336h0m0s", client.TrustingPeriod)
+	require.False(t, client.IsExpired())
+
+	client = NewClientStatus("hub-1", "07-tendermint-0", "spoke-1", ClientStatusActive, trustingPeriod, now.Add(-15*24*time.Hour), now)
+	require.Equal(t, "-24h0m0s", client.Headroom)
+	require.True(t, client.IsExpired())
+}
+
+func TestStatusReportIssues(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	report := StatusReport{
+		Clients: []ClientStatus{
+			NewClientStatus("hub-1", "07-tendermint-0", "spoke-1", ClientStatusActive, time.Hour, now, now),
+			NewClientStatus("hub-1", "07-tendermint-1", "spoke-1", "Frozen", time.Hour, now, now),
+			NewClientStatus("spoke-1", "07-tendermint-0", "hub-1", ClientStatusActive, time.Hour, now.Add(-2*time.Hour), now),
+		},
+		Connections: []ConnectionStatus{
+			{ChainID: "hub-1", ConnectionID: "connection-0", State: StateOpen},
+			{ChainID: "hub-1", ConnectionID: "connection-1", State: "Init"},
+		},
+		Channels: []ChannelStatus{
+			{ChainID: "hub-1", PortID: "transfer", ChannelID: "channel-0", State: StateOpen},
+			{
+				ChainID:   "hub-1",
+				PortID:    "transfer",
+				ChannelID: "channel-1",
+				State:     StateOpen,
+				PendingPackets: PendingPacketsResult{
+					Src: PendingPackets{UnreceivedPackets: []uint64{1, 2}},
+					Dst: PendingPackets{UnreceivedAcks: []uint64{3}},
+				},
+			},
+		},
+		Errors: []string{"failed to query spoke-2"},
+	}
+
+	require.Equal(t, []string{
+		"client 07-tendermint-1 on hub-1 is Frozen",
+		"client 07-tendermint-0 on spoke-1 trusting period expired at 2024-01-10T11:00:00Z",
+		"connection connection-1 on hub-1 is Init",
+		"channel transfer/channel-1 on hub-1 has 3 pending packets and acknowledgments",
+		"failed to query spoke-2",
+	}, report.Issues())
+
+	require.Empty(t, StatusReport{}.Issues())
+
+	out, err := report.JSON()
+	require.NoError(t, err)
+	var decoded StatusReport
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	require.Len(t, decoded.Clients, 3)
+	require.Equal(t, "07-tendermint-1", decoded.Clients[1].ClientID)
+}
@@ -0,0 +1,14 @@
+package hermes
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// IBCDenom returns the IBC denom of the base denom received through the port and channel.
+// The denom is the hash of the denom trace, e.g. ibc/27394FB0... for transfer/channel-0/uatom.
+func IBCDenom(portID, channelID, baseDenom string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", portID, channelID, baseDenom)))
+	return fmt.Sprintf("ibc/%s", strings.ToUpper(fmt.Sprintf("%x", hash)))
+}
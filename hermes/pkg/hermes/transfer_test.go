@@ -0,0 +1,15 @@
+package hermes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBCDenom(t *testing.T) {
+	require.Equal(
+		t,
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		IBCDenom("transfer", "channel-0", "uatom"),
+	)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/services/plugin"
 	"github.com/schollz/progressbar/v3"
 
+	"github.com/ignite/apps/spaceship/pkg/ssh"
 	"github.com/ignite/apps/spaceship/pkg/tarball"
 	"github.com/ignite/apps/spaceship/templates/script"
 )
@@ -26,6 +27,10 @@ const (
 
 // ExecuteSSHStatus executes the ssh status subcommand.
 func ExecuteSSHStatus(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	if spec, _ := plugin.Flags(cmd.Flags).GetString(flagSpec); spec != "" {
+		return executeFleetStatus(ctx, cmd, chain)
+	}
+
 	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
 	defer session.End()
 
@@ -45,6 +50,12 @@ func ExecuteSSHStatus(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 	}
 	_ = session.Println(chainStatus)
 
+	if c.HasCosmovisor(ctx) {
+		if err := printUpgradeVersions(session, cmd.Args[0], c); err != nil {
+			return err
+		}
+	}
+
 	flags := plugin.Flags(cmd.Flags)
 	faucet, _ := flags.GetBool(flagFaucet)
 	if faucet {
@@ -63,6 +74,10 @@ func ExecuteSSHStatus(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 
 // ExecuteSSHRestart executes the ssh restart subcommand.
 func ExecuteSSHRestart(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	if spec, _ := plugin.Flags(cmd.Flags).GetString(flagSpec); spec != "" {
+		return executeFleetRestart(ctx, cmd, chain)
+	}
+
 	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
 	defer session.End()
 
@@ -105,6 +120,10 @@ func ExecuteSSHRestart(ctx context.Context, cmd *plugin.ExecutedCommand, chain *
 
 // ExecuteSSHSStop executes the ssh stop subcommand.
 func ExecuteSSHSStop(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	if spec, _ := plugin.Flags(cmd.Flags).GetString(flagSpec); spec != "" {
+		return executeFleetStop(ctx, cmd, chain)
+	}
+
 	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
 	defer session.End()
 
@@ -142,6 +161,10 @@ func ExecuteSSHSStop(ctx context.Context, cmd *plugin.ExecutedCommand, chain *pl
 
 // ExecuteSSHDeploy executes the ssh deploy subcommand.
 func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	if spec, _ := plugin.Flags(cmd.Flags).GetString(flagSpec); spec != "" {
+		return executeFleetDeploy(ctx, cmd, chain)
+	}
+
 	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
 	defer session.End()
 
@@ -156,13 +179,20 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 	}()
 
 	var (
-		initChain, _ = flags.GetBool(flagInitChain)
-		faucet, _    = flags.GetBool(flagFaucet)
+		initChain, _     = flags.GetBool(flagInitChain)
+		faucet, _        = flags.GetBool(flagFaucet)
+		useSystemd, _    = flags.GetBool(flagSystemd)
+		useCosmovisor, _ = flags.GetBool(flagCosmovisor)
 
 		localChainHome = filepath.Join(localDir, "home")
 		localBinOutput = filepath.Join(localDir, "bin")
 	)
 
+	overlay, err := nodeConfigOverlay(flags)
+	if err != nil {
+		return err
+	}
+
 	c, err := executeSSH(session, cmd, chain)
 	if err != nil {
 		return err
@@ -193,23 +223,7 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 		return err
 	}
 
-	// Define a progress callback function.
-	bar := progressbar.DefaultBytes(1, "uploading")
-	progressCallback := func(bytesUploaded int64, totalBytes int64) error {
-		if bar.GetMax64() != totalBytes {
-			bar.ChangeMax64(totalBytes)
-			bar.Reset()
-		}
-		if err := bar.Set64(bytesUploaded); err != nil {
-			return err
-		}
-		if bytesUploaded == totalBytes {
-			if err := bar.Finish(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}
+	bar, progressCallback := uploadProgress()
 
 	// Extract and upload the built binary.
 	var (
@@ -230,7 +244,7 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 	}
 
 	bar.Describe("Uploading chain binary")
-	chainBinPath, err := c.UploadBinary(extracted[0], progressCallback)
+	chainBinPath, err := c.UploadBinary(ctx, extracted[0], progressCallback)
 	if err != nil {
 		return err
 	}
@@ -245,7 +259,8 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 	_ = session.Println()
 
 	home := c.Home()
-	if initChain || !c.HasGenesis(ctx) {
+	initHome := initChain || !c.HasGenesis(ctx)
+	if initHome {
 		_ = session.Println(color.Yellow.Sprint("Initializing the chain home folder using Ignite:"))
 
 		igniteChainInitCmd := ignitecmd.NewChainInit()
@@ -254,6 +269,10 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 			return err
 		}
 
+		if err := configureHome(ctx, session, c, overlay, localChainHome, "", true, progressCallback); err != nil {
+			return err
+		}
+
 		bar.Describe("Uploading chain home folder")
 		homeFiles, err := c.UploadHome(ctx, localChainHome, progressCallback)
 		if err != nil {
@@ -261,21 +280,37 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 		}
 		_ = session.Println()
 		_ = session.Println(color.Yellow.Sprintf("Uploaded files: \n- %s\n", strings.Join(homeFiles, "\n- ")))
+	} else {
+		bar.Describe("Uploading chain config")
+		if err := configureHome(ctx, session, c, overlay, localChainHome, "", false, progressCallback); err != nil {
+			return err
+		}
 	}
 	_ = session.Println()
 
+	cosmovisorBin, err := setupCosmovisor(
+		ctx,
+		session,
+		c,
+		useCosmovisor,
+		initHome,
+		target,
+		extracted[0],
+		bar,
+		progressCallback,
+	)
+	if err != nil {
+		return err
+	}
+
 	chainCfg, err := chainConfig(chain)
 	if err != nil {
 		return err
 	}
 
-	denom := "token"
-	if len(chainCfg.Faucet.Coins) > 0 {
-		coin, err := sdk.ParseCoinNormalized(chainCfg.Faucet.Coins[0])
-		if err != nil {
-			return err
-		}
-		denom = coin.Denom
+	denom, err := faucetDenom(chainCfg)
+	if err != nil {
+		return err
 	}
 
 	// Create the chain and faucet runner scripts.
@@ -287,6 +322,7 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 		c.Bin(),
 		chainBinPath,
 		faucetBin,
+		cosmovisorBin,
 		*chainCfg.Faucet.Name,
 		denom,
 		scriptsDir,
@@ -299,6 +335,23 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 		return err
 	}
 
+	if err := setupSystemd(
+		ctx,
+		session,
+		c,
+		useSystemd,
+		chainBinPath,
+		faucetBin,
+		cosmovisorBin,
+		*chainCfg.Faucet.Name,
+		denom,
+		localDir,
+		progressCallback,
+	); err != nil {
+		return err
+	}
+	_ = session.Println(color.Yellow.Sprint(c.Transfers()))
+
 	_ = session.Println(color.Yellow.Sprintf("Running chain %s", chain.ChainId))
 	start, err := c.Start(ctx)
 	if err != nil {
@@ -323,6 +376,38 @@ func ExecuteSSHDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *p
 	return nil
 }
 
+// uploadProgress returns a progress bar and its upload progress callback function.
+func uploadProgress() (*progressbar.ProgressBar, ssh.ProgressCallback) {
+	bar := progressbar.DefaultBytes(1, "uploading")
+	return bar, func(bytesUploaded int64, totalBytes int64) error {
+		if bar.GetMax64() != totalBytes {
+			bar.ChangeMax64(totalBytes)
+			bar.Reset()
+		}
+		if err := bar.Set64(bytesUploaded); err != nil {
+			return err
+		}
+		if bytesUploaded == totalBytes {
+			if err := bar.Finish(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// faucetDenom returns the denom of the first faucet coin from the chain config.
+func faucetDenom(chainCfg *config.Config) (string, error) {
+	if len(chainCfg.Faucet.Coins) == 0 {
+		return "token", nil
+	}
+	coin, err := sdk.ParseCoinNormalized(chainCfg.Faucet.Coins[0])
+	if err != nil {
+		return "", err
+	}
+	return coin.Denom, nil
+}
+
 // chainConfig retrieves and parses the configuration for the given chain.
 // It first attempts to load the config from chain's specified path, if unsuccessful,
 // it attempts to locate and load the default config file.
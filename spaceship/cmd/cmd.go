@@ -9,6 +9,12 @@ import (
 	"github.com/ignite/apps/spaceship/pkg/ssh"
 )
 
+var specFlag = &plugin.Flag{
+	Name:  flagSpec,
+	Usage: "deployment spec file listing the fleet nodes, instead of the host",
+	Type:  plugin.FlagTypeString,
+}
+
 var defaultFlags = []*plugin.Flag{
 	{
 		Name:      flagUser,
@@ -57,12 +63,33 @@ func GetCommands() []*plugin.Command {
 					Use:   "deploy [host]",
 					Short: "deploy the chain",
 					Flags: append(defaultFlags,
+						specFlag,
 						&plugin.Flag{
 							Name:      flagInitChain,
 							Shorthand: "i",
 							Usage:     "run init chain and create the home folder",
 							Type:      plugin.FlagTypeBool,
 						},
+						&plugin.Flag{
+							Name:  flagSystemd,
+							Usage: "run the chain and faucet as systemd user services",
+							Type:  plugin.FlagTypeBool,
+						},
+						&plugin.Flag{
+							Name:  flagCosmovisor,
+							Usage: "run the chain with cosmovisor to support governance upgrades",
+							Type:  plugin.FlagTypeBool,
+						},
+						&plugin.Flag{
+							Name:  flagConfigFile,
+							Usage: "YAML file with the app, config and client toml values to set on the nodes",
+							Type:  plugin.FlagTypeString,
+						},
+						&plugin.Flag{
+							Name:  flagConfigSet,
+							Usage: "set a node config value, e.g. app.api.enable=true or config.rpc.laddr=tcp://0.0.0.0:26657",
+							Type:  plugin.FlagTypeStringSlice,
+						},
 						&plugin.Flag{
 							Name:         flagFaucet,
 							Shorthand:    "f",
@@ -77,6 +104,39 @@ func GetCommands() []*plugin.Command {
 						},
 					),
 				},
+				{
+					Use:   "upgrade [host]",
+					Short: "upgrade the chain run by cosmovisor",
+					Flags: append(defaultFlags,
+						specFlag,
+						&plugin.Flag{
+							Name:  flagUpgradeName,
+							Usage: "upgrade name registered by the chain upgrade handler",
+							Type:  plugin.FlagTypeString,
+						},
+						&plugin.Flag{
+							Name:  flagUpgradeHeight,
+							Usage: "submit and vote a software upgrade proposal for the height",
+							Type:  plugin.FlagTypeUint64,
+						},
+						&plugin.Flag{
+							Name:  flagDeposit,
+							Usage: "upgrade proposal deposit, by default the gov min deposit",
+							Type:  plugin.FlagTypeString,
+						},
+						&plugin.Flag{
+							Name:  flagWait,
+							Usage: "wait for cosmovisor to upgrade the nodes at the upgrade height",
+							Type:  plugin.FlagTypeBool,
+						},
+						&plugin.Flag{
+							Name:         flagWaitTimeout,
+							Usage:        "maximum duration to wait for the upgrade with --wait",
+							Type:         plugin.FlagTypeString,
+							DefaultValue: "1h",
+						},
+					),
+				},
 				{
 					Use:   "log [host]",
 					Short: "get remote logs",
@@ -84,7 +144,7 @@ func GetCommands() []*plugin.Command {
 						&plugin.Flag{
 							Name:         flagLines,
 							Shorthand:    "l",
-							Usage:        "number of lines of chain logs",
+							Usage:        "number of lines of chain logs, 0 for all the matching lines",
 							Type:         plugin.FlagTypeInt,
 							DefaultValue: "100",
 						},
@@ -103,12 +163,33 @@ func GetCommands() []*plugin.Command {
 							Type:         plugin.FlagTypeString,
 							DefaultValue: ssh.LogChain.String(),
 						},
+						&plugin.Flag{
+							Name:  flagSince,
+							Usage: "show the logs since a date (2006-01-02 15:04:05 UTC) or a duration ago (e.g. 2h)",
+							Type:  plugin.FlagTypeString,
+						},
+						&plugin.Flag{
+							Name:  flagUntil,
+							Usage: "show the logs until a date (2006-01-02 15:04:05 UTC) or a duration ago (e.g. 30m)",
+							Type:  plugin.FlagTypeString,
+						},
+						&plugin.Flag{
+							Name:  flagGrep,
+							Usage: "show the log lines matching the regular expression",
+							Type:  plugin.FlagTypeString,
+						},
+						&plugin.Flag{
+							Name:  flagDownload,
+							Usage: "download the log directory into a tarball in the current directory",
+							Type:  plugin.FlagTypeBool,
+						},
 					),
 				},
 				{
 					Use:   "status [host]",
 					Short: "get chain status if its running",
 					Flags: append(defaultFlags,
+						specFlag,
 						&plugin.Flag{
 							Name:         flagFaucet,
 							Shorthand:    "f",
@@ -122,6 +203,7 @@ func GetCommands() []*plugin.Command {
 					Use:   "restart [host]",
 					Short: "restart the chain",
 					Flags: append(defaultFlags,
+						specFlag,
 						&plugin.Flag{
 							Name:         flagFaucet,
 							Shorthand:    "f",
@@ -140,6 +222,7 @@ func GetCommands() []*plugin.Command {
 					Use:   "stop [host]",
 					Short: "stop the chain",
 					Flags: append(defaultFlags,
+						specFlag,
 						&plugin.Flag{
 							Name:         flagFaucet,
 							Shorthand:    "f",
@@ -187,6 +270,62 @@ func GetCommands() []*plugin.Command {
 						},
 					},
 				},
+				{
+					Use:   "snapshot",
+					Short: "chain state snapshot commands",
+					Commands: []*plugin.Command{
+						{
+							Use:   "create [host]",
+							Short: "stop the chain and archive its config and data",
+							Flags: append(defaultFlags,
+								&plugin.Flag{
+									Name:  flagSnapshotName,
+									Usage: "snapshot name (default to the current time)",
+									Type:  plugin.FlagTypeString,
+								},
+								&plugin.Flag{
+									Name:         flagDownload,
+									Usage:        "download the snapshot into the current directory instead of keeping it on the server",
+									Type:         plugin.FlagTypeBool,
+									DefaultValue: "false",
+								},
+							),
+						},
+						{
+							Use:   "list [host]",
+							Short: "list the chain snapshots stored on the server",
+							Flags: defaultFlags,
+						},
+						{
+							Use:   "restore [host]",
+							Short: "restore the chain config and data from a snapshot",
+							Flags: append(defaultFlags,
+								&plugin.Flag{
+									Name:  flagSnapshotName,
+									Usage: "name of the snapshot stored on the server",
+									Type:  plugin.FlagTypeString,
+								},
+								&plugin.Flag{
+									Name:  flagSnapshotFile,
+									Usage: "local snapshot tarball",
+									Type:  plugin.FlagTypeString,
+								},
+							),
+						},
+						{
+							Use:   "prune [host]",
+							Short: "remove the oldest chain snapshots stored on the server",
+							Flags: append(defaultFlags,
+								&plugin.Flag{
+									Name:         flagKeep,
+									Usage:        "number of snapshots to keep",
+									Type:         plugin.FlagTypeInt,
+									DefaultValue: "3",
+								},
+							),
+						},
+					},
+				},
 			},
 		},
 	}
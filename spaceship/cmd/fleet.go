@@ -0,0 +1,495 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/gookit/color"
+	ignitecmd "github.com/ignite/cli/v29/ignite/cmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/spaceship/pkg/fleet"
+	"github.com/ignite/apps/spaceship/pkg/nodeconfig"
+	"github.com/ignite/apps/spaceship/pkg/ssh"
+	"github.com/ignite/apps/spaceship/pkg/tarball"
+	"github.com/ignite/apps/spaceship/templates/script"
+)
+
+const flagSpec = "spec"
+
+// fleetNode represents a fleet node connected through SSH.
+type fleetNode struct {
+	fleet.Node
+	client *ssh.SSH
+}
+
+// connectFleet loads the deployment spec and connects to each node. The node SSH
+// settings take precedence over the command flags.
+func connectFleet(session *cliui.Session, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) (fleet.Spec, []fleetNode, error) {
+	var (
+		flags           = plugin.Flags(cmd.Flags)
+		specPath, _     = flags.GetString(flagSpec)
+		user, _         = flags.GetString(flagUser)
+		userPassword, _ = flags.GetString(flagUserPassword)
+		port, _         = flags.GetString(flagPort)
+		key, _          = flags.GetString(flagKey)
+		rawKey, _       = flags.GetString(flagRawKey)
+		keyPassword, _  = flags.GetString(flagKeyPassword)
+	)
+
+	spec, err := fleet.LoadSpec(specPath)
+	if err != nil {
+		return fleet.Spec{}, nil, err
+	}
+
+	nodes := make([]fleetNode, 0, len(spec.Nodes))
+	for _, node := range spec.Nodes {
+		session.StartSpinner(fmt.Sprintf("Connecting to node %s...", node.Name))
+
+		// the node user and port, or the flags ones, are set in the host URI.
+		host, err := node.SSHHost(user, port)
+		if err != nil {
+			closeFleet(nodes)
+			return fleet.Spec{}, nil, err
+		}
+		options := []ssh.Option{ssh.WithWorkspace(chain.ChainId)}
+		// the flags authentication is only used if the node has none.
+		if node.Key != "" || node.Password != "" {
+			options = append(options,
+				ssh.WithKey(node.Key),
+				ssh.WithUserPassword(node.Password),
+				ssh.WithKeyPassword(node.KeyPassword),
+			)
+		} else {
+			options = append(options,
+				ssh.WithKey(key),
+				ssh.WithRawKey(rawKey),
+				ssh.WithUserPassword(userPassword),
+				ssh.WithKeyPassword(keyPassword),
+			)
+		}
+
+		c, err := connectSSH(session, host, options...)
+		if err != nil {
+			closeFleet(nodes)
+			return fleet.Spec{}, nil, errors.Wrapf(err, "failed to connect to node %s", node.Name)
+		}
+		nodes = append(nodes, fleetNode{Node: node, client: c})
+	}
+	session.StopSpinner()
+	return spec, nodes, nil
+}
+
+// closeFleet closes the nodes SSH connections.
+func closeFleet(nodes []fleetNode) {
+	for _, node := range nodes {
+		_ = node.client.Close()
+	}
+}
+
+// executeFleetStatus shows the chain and faucet status of each fleet node.
+func executeFleetStatus(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	_, nodes, err := connectFleet(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer closeFleet(nodes)
+
+	rows := make([][]string, 0, len(nodes))
+	for _, node := range nodes {
+		status := "not initialized"
+		if node.client.HasRunnerScript(ctx) {
+			if status, err = node.client.Status(ctx); err != nil {
+				return errors.Wrapf(err, "failed to get node %s status", node.Name)
+			}
+		}
+		if node.IsFaucet() && node.client.HasFaucetScript(ctx) {
+			faucetStatus, err := node.client.FaucetStatus(ctx)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get node %s faucet status", node.Name)
+			}
+			status = fmt.Sprintf("%s %s", status, faucetStatus)
+		}
+		rows = append(rows, []string{node.Name, node.Role.String(), node.Host, status})
+	}
+	return session.PrintTable([]string{"Node", "Role", "Host", "Status"}, rows...)
+}
+
+// executeFleetRestart restarts the chain of each fleet node, and the faucet of the faucet nodes.
+func executeFleetRestart(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	_, nodes, err := connectFleet(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer closeFleet(nodes)
+
+	for _, node := range nodes {
+		if !node.client.HasRunnerScript(ctx) {
+			return errors.Wrapf(ErrServerNotInitialized, "node %s", node.Name)
+		}
+		if err := restartFleetNode(ctx, session, cmd, node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeFleetStop stops the chain of each fleet node, and the faucet of the faucet nodes.
+func executeFleetStop(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	_, nodes, err := connectFleet(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer closeFleet(nodes)
+
+	for _, node := range nodes {
+		if !node.client.HasRunnerScript(ctx) {
+			return errors.Wrapf(ErrServerNotInitialized, "node %s", node.Name)
+		}
+		chainStop, err := node.client.Stop(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to stop node %s", node.Name)
+		}
+		_ = session.Println(color.Yellow.Sprintf("%s: %s", node.Name, chainStop))
+
+		if node.IsFaucet() && node.client.HasFaucetScript(ctx) {
+			faucetStop, err := node.client.FaucetStop(ctx)
+			if err != nil {
+				return errors.Wrapf(err, "failed to stop node %s faucet", node.Name)
+			}
+			_ = session.Println(color.Yellow.Sprintf("%s: %s", node.Name, faucetStop))
+		}
+	}
+	return nil
+}
+
+// restartFleetNode restarts the node chain, and the faucet of a faucet node.
+func restartFleetNode(ctx context.Context, session *cliui.Session, cmd *plugin.ExecutedCommand, node fleetNode) error {
+	chainRestart, err := node.client.Restart(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to restart node %s", node.Name)
+	}
+	_ = session.Println(color.Blue.Sprintf("%s: %s", node.Name, chainRestart))
+
+	if !node.IsFaucet() {
+		return nil
+	}
+	port, err := faucetPort(cmd.Flags)
+	if err != nil {
+		return err
+	}
+	faucetRestart, err := node.client.FaucetRestart(ctx, port)
+	if err != nil {
+		return errors.Wrapf(err, "failed to restart node %s faucet", node.Name)
+	}
+	_ = session.Println(color.Blue.Sprintf("%s: %s", node.Name, faucetRestart))
+	return nil
+}
+
+// executeFleetDeploy builds the chain binary for each fleet node target, generates the node
+// homes sharing the same genesis if the fleet is not initialized and (re)starts the nodes.
+func executeFleetDeploy(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	flags := plugin.Flags(cmd.Flags)
+
+	localDir, err := os.MkdirTemp(os.TempDir(), "spaceship")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(localDir)
+	}()
+
+	var (
+		initChain, _     = flags.GetBool(flagInitChain)
+		useSystemd, _    = flags.GetBool(flagSystemd)
+		useCosmovisor, _ = flags.GetBool(flagCosmovisor)
+
+		localChainHome = filepath.Join(localDir, "home")
+		localBinOutput = filepath.Join(localDir, "bin")
+	)
+
+	spec, nodes, err := connectFleet(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer closeFleet(nodes)
+
+	overlays := make(map[string]nodeconfig.Overlay)
+	for _, node := range nodes {
+		if overlays[node.Name], err = nodeConfigOverlay(flags, spec.Config, node.Config); err != nil {
+			return err
+		}
+	}
+
+	chainCfg, err := chainConfig(chain)
+	if err != nil {
+		return err
+	}
+
+	// The local target binary is used to generate the node homes.
+	var (
+		localTarget = gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)
+		nodeTargets = make(map[string]string)
+		targets     = []string{localTarget}
+	)
+	for _, node := range nodes {
+		target, err := node.client.Target(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get node %s target", node.Name)
+		}
+		nodeTargets[node.Name] = target
+		if !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+
+	binaries, err := buildChainBinaries(ctx, session, chain, localBinOutput, targets)
+	if err != nil {
+		return err
+	}
+
+	initialized := 0
+	for _, node := range nodes {
+		if node.client.HasGenesis(ctx) {
+			initialized++
+		}
+	}
+	if initialized > 0 && initialized < len(nodes) && !initChain {
+		return errors.Errorf(
+			"only %d of %d fleet nodes are initialized, use the --%s flag to initialize the fleet again",
+			initialized,
+			len(nodes),
+			flagInitChain,
+		)
+	}
+
+	bar, progressCallback := uploadProgress()
+	initFleet := initChain || initialized == 0
+	if initFleet {
+		_ = session.Println(color.Yellow.Sprint("Initializing the fleet home folders using Ignite:"))
+
+		igniteChainInitCmd := ignitecmd.NewChainInit()
+		igniteChainInitCmd.SetArgs([]string{"-p", chain.AppPath, "--home", localChainHome})
+		if err := igniteChainInitCmd.ExecuteContext(ctx); err != nil {
+			return err
+		}
+
+		bonded := ""
+		if len(chainCfg.Validators) > 0 {
+			bonded = chainCfg.Validators[0].Bonded
+		}
+		homes, err := fleet.GenerateHomes(
+			ctx,
+			spec,
+			binaries[localTarget],
+			localChainHome,
+			filepath.Join(localDir, "homes"),
+			bonded,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			// the running nodes must be stopped before replacing their home.
+			if node.client.HasRunnerScript(ctx) {
+				if _, err := node.client.Stop(ctx); err != nil {
+					return errors.Wrapf(err, "failed to stop node %s", node.Name)
+				}
+			}
+			if err := node.client.RemoveHome(ctx); err != nil {
+				return errors.Wrapf(err, "failed to remove node %s home", node.Name)
+			}
+
+			_ = session.Println(color.Yellow.Sprintf("Node %s config:", node.Name))
+			if err := configureHome(
+				ctx,
+				session,
+				node.client,
+				overlays[node.Name],
+				homes[node.Name],
+				node.P2PAddress,
+				true,
+				progressCallback,
+			); err != nil {
+				return errors.Wrapf(err, "failed to configure node %s", node.Name)
+			}
+
+			bar.Describe(fmt.Sprintf("Uploading node %s home folder", node.Name))
+			if _, err := node.client.UploadHome(ctx, homes[node.Name], progressCallback); err != nil {
+				return err
+			}
+			_ = session.Println()
+		}
+	} else {
+		for _, node := range nodes {
+			_ = session.Println(color.Yellow.Sprintf("Node %s config:", node.Name))
+			bar.Describe(fmt.Sprintf("Uploading node %s config", node.Name))
+			if err := configureHome(
+				ctx,
+				session,
+				node.client,
+				overlays[node.Name],
+				filepath.Join(localDir, "homes", node.Name),
+				node.P2PAddress,
+				false,
+				progressCallback,
+			); err != nil {
+				return errors.Wrapf(err, "failed to configure node %s", node.Name)
+			}
+		}
+	}
+
+	denom, err := faucetDenom(chainCfg)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		target := nodeTargets[node.Name]
+
+		bar.Describe(fmt.Sprintf("Uploading node %s chain binary", node.Name))
+		chainBinPath, err := node.client.UploadBinary(ctx, binaries[target], progressCallback)
+		if err != nil {
+			return err
+		}
+		_ = session.Println()
+
+		faucetBin := ""
+		if node.IsFaucet() {
+			bar.Describe(fmt.Sprintf("Uploading node %s faucet binary", node.Name))
+			faucetBin, err = node.client.UploadFaucetBinary(ctx, strings.ReplaceAll(target, ":", "_"), progressCallback)
+			if err != nil {
+				return err
+			}
+			_ = session.Println()
+		}
+
+		cosmovisorBin, err := setupCosmovisor(
+			ctx,
+			session,
+			node.client,
+			useCosmovisor,
+			initFleet,
+			target,
+			binaries[target],
+			bar,
+			progressCallback,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to set up node %s cosmovisor", node.Name)
+		}
+
+		// Create the chain and faucet runner scripts.
+		scriptsDir := filepath.Join(localDir, "scripts", node.Name)
+		if err := script.NewRunScripts(
+			node.client.Workspace(),
+			node.client.Log(),
+			node.client.Home(),
+			node.client.Bin(),
+			chainBinPath,
+			faucetBin,
+			cosmovisorBin,
+			*chainCfg.Faucet.Name,
+			denom,
+			scriptsDir,
+		); err != nil {
+			return err
+		}
+
+		bar.Describe(fmt.Sprintf("Uploading node %s runner script", node.Name))
+		if err := node.client.UploadScripts(ctx, scriptsDir, progressCallback); err != nil {
+			return err
+		}
+		_ = session.Println()
+
+		if err := setupSystemd(
+			ctx,
+			session,
+			node.client,
+			useSystemd,
+			chainBinPath,
+			faucetBin,
+			cosmovisorBin,
+			*chainCfg.Faucet.Name,
+			denom,
+			filepath.Join(localDir, node.Name),
+			progressCallback,
+		); err != nil {
+			return errors.Wrapf(err, "failed to set up node %s systemd units", node.Name)
+		}
+		_ = session.Println(color.Yellow.Sprintf("Node %s: %s", node.Name, node.client.Transfers()))
+
+		_ = session.Println(color.Yellow.Sprintf("Running chain %s node %s (%s)", chain.ChainId, node.Name, node.Role))
+		if err := restartFleetNode(ctx, session, cmd, node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildChainBinaries builds the chain release binaries for the targets using Ignite and
+// returns the extracted binary path of each target.
+func buildChainBinaries(
+	ctx context.Context,
+	session *cliui.Session,
+	chain *plugin.ChainInfo,
+	output string,
+	targets []string,
+) (map[string]string, error) {
+	_ = session.Println(color.Yellow.Sprint("Building chain binaries using Ignite:"))
+	igniteChainBuildCmd := ignitecmd.NewChainBuild()
+	igniteChainBuildCmd.SetArgs([]string{
+		"-p",
+		chain.AppPath,
+		"-o",
+		output,
+		"--release",
+		"--release.targets",
+		strings.Join(targets, ","),
+		"--verbose",
+	})
+	if err := igniteChainBuildCmd.ExecuteContext(ctx); err != nil {
+		return nil, err
+	}
+
+	var (
+		binName  = fmt.Sprintf("%sd", chain.ChainId)
+		binaries = make(map[string]string)
+	)
+	for _, target := range targets {
+		var (
+			targetName        = strings.ReplaceAll(target, ":", "_")
+			localChainTarball = fmt.Sprintf("%s/%s_%s.tar.gz", output, chain.ChainId, targetName)
+		)
+		extracted, err := tarball.ExtractFile(ctx, localChainTarball, filepath.Join(output, targetName), binName)
+		if err != nil {
+			return nil, err
+		}
+		if len(extracted) == 0 {
+			return nil, errors.Errorf("zero files extracted from the tarball %s", localChainTarball)
+		}
+		binaries[target] = extracted[0]
+	}
+	return binaries, nil
+}
@@ -2,7 +2,12 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
 
+	"github.com/gookit/color"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/services/plugin"
@@ -15,6 +20,10 @@ const (
 	flagLines    = "lines"
 	flagRealTime = "real-time"
 	flagAppLog   = "app"
+	flagSince    = "since"
+	flagUntil    = "until"
+	flagGrep     = "grep"
+	flagDownload = "download"
 )
 
 // ExecuteSSHLog executes the ssh log subcommand.
@@ -27,6 +36,10 @@ func ExecuteSSHLog(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plug
 		lines, _    = flags.GetInt(flagLines)
 		realTime, _ = flags.GetBool(flagRealTime)
 		appLog, _   = flags.GetString(flagAppLog)
+		since, _    = flags.GetString(flagSince)
+		until, _    = flags.GetString(flagUntil)
+		grep, _     = flags.GetString(flagGrep)
+		download, _ = flags.GetBool(flagDownload)
 	)
 
 	logType, err := ssh.ParseLogType(appLog)
@@ -34,6 +47,11 @@ func ExecuteSSHLog(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plug
 		return err
 	}
 
+	options, err := logOptions(since, until, grep)
+	if err != nil {
+		return err
+	}
+
 	c, err := executeSSH(session, cmd, chain)
 	if err != nil {
 		return err
@@ -44,7 +62,11 @@ func ExecuteSSHLog(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plug
 		return ErrServerNotInitialized
 	}
 
-	logs, err := c.LatestLog(logType, lines)
+	if download {
+		return downloadLogs(ctx, session, c, chain.ChainId)
+	}
+
+	logs, err := c.LatestLog(ctx, logType, lines, options...)
 	if err != nil {
 		return err
 	}
@@ -57,7 +79,7 @@ func ExecuteSSHLog(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plug
 
 		// Start the FollowLog method in a goroutine using errgroup
 		g.Go(func() error {
-			return c.FollowLog(ctx, logType, logChannel)
+			return c.FollowLog(ctx, logType, logChannel, options...)
 		})
 
 		// Start a goroutine to consume log lines
@@ -80,3 +102,51 @@ func ExecuteSSHLog(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plug
 
 	return nil
 }
+
+// logOptions returns the log options from the time filters and the regular expression.
+func logOptions(since, until, grep string) ([]ssh.LogOption, error) {
+	var (
+		now     = time.Now()
+		options = make([]ssh.LogOption, 0)
+	)
+	if since != "" {
+		t, err := ssh.ParseLogTime(since, now)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, ssh.WithLogSince(t))
+	}
+	if until != "" {
+		t, err := ssh.ParseLogTime(until, now)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, ssh.WithLogUntil(t))
+	}
+	if grep != "" {
+		re, err := regexp.Compile(grep)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid --%s regular expression", flagGrep)
+		}
+		options = append(options, ssh.WithLogGrep(re))
+	}
+	return options, nil
+}
+
+// downloadLogs downloads the remote logs into a tarball in the current directory.
+func downloadLogs(ctx context.Context, session *cliui.Session, c *ssh.SSH, chainID string) error {
+	session.StartSpinner("Downloading logs...")
+	path := fmt.Sprintf("%s_logs_%s.tar.gz", chainID, time.Now().Format("2006-01-02_15-04-05"))
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := c.DownloadLogs(ctx, file); err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+	session.StopSpinner()
+	return session.Println(color.Yellow.Sprintf("Logs downloaded to '%s'", path))
+}
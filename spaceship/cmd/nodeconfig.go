@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/spaceship/pkg/nodeconfig"
+	"github.com/ignite/apps/spaceship/pkg/ssh"
+)
+
+const (
+	flagConfigSet  = "config-set"
+	flagConfigFile = "config-file"
+)
+
+// nodeConfigOverlay returns the node config overlay of the config file and config settings flags,
+// overriding the overlays passed, e.g. from the deployment spec.
+func nodeConfigOverlay(flags plugin.Flags, overlays ...nodeconfig.Overlay) (nodeconfig.Overlay, error) {
+	var (
+		configFile, _ = flags.GetString(flagConfigFile)
+		configSet, _  = flags.GetStringSlice(flagConfigSet)
+	)
+	if configFile != "" {
+		overlay, err := nodeconfig.LoadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		overlays = append(overlays, overlay)
+	}
+
+	overlay, err := nodeconfig.ParseSet(configSet)
+	if err != nil {
+		return nil, err
+	}
+	return nodeconfig.Merge(append(overlays, overlay)...), nil
+}
+
+// configureHome patches the node config files of the home with the overlay and prints the
+// changes. The p2p external address is set from the p2p address, or the SSH host, if not
+// set by the overlay. If the home is not initialized, the remote config files are downloaded
+// into the home, patched and uploaded again, otherwise they are uploaded with the home.
+func configureHome(
+	ctx context.Context,
+	session *cliui.Session,
+	c *ssh.SSH,
+	overlay nodeconfig.Overlay,
+	home,
+	p2pAddress string,
+	initHome bool,
+	progressCallback ssh.ProgressCallback,
+) error {
+	if !initHome {
+		for _, file := range nodeconfig.Files() {
+			if _, ok := overlay[file]; !ok && file != nodeconfig.FileConfig {
+				continue
+			}
+			if err := c.DownloadFile(file.Path(c.Home()), file.Path(home)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, ok := overlay.Get(nodeconfig.FileConfig, "p2p", "external_address"); !ok {
+		if p2pAddress == "" {
+			addr, err := nodeconfig.ExternalAddress(home, c.Host())
+			if err != nil {
+				return err
+			}
+			p2pAddress = addr
+		}
+		overlay = nodeconfig.Merge(overlay, nodeconfig.Overlay{
+			nodeconfig.FileConfig: {"p2p": {"external_address": strconv.Quote(p2pAddress)}},
+		})
+	}
+
+	changes, err := overlay.Apply(home)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	printConfigChanges(session, changes)
+	if initHome {
+		return nil
+	}
+
+	for _, change := range changes {
+		if _, err := c.UploadFile(ctx, change.File.Path(home), change.File.Path(c.Home()), progressCallback); err != nil {
+			return err
+		}
+		_ = session.Println()
+	}
+	return nil
+}
+
+// printConfigChanges prints the removed and added lines of the node config files by section.
+func printConfigChanges(session *cliui.Session, changes []nodeconfig.Change) {
+	for _, change := range changes {
+		_ = session.Println(color.Yellow.Sprintf("config/%s.toml:", change.File))
+		section := "-"
+		for _, line := range change.Diff {
+			if line.Section != section {
+				section = line.Section
+				if section != "" {
+					_ = session.Printf("  [%s]\n", section)
+				}
+			}
+			if line.Op == nodeconfig.DiffRemoved {
+				_ = session.Println(color.Red.Sprintf("  %s", line))
+			} else {
+				_ = session.Println(color.Green.Sprintf("  %s", line))
+			}
+		}
+	}
+	_ = session.Println()
+}
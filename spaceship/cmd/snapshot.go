@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+
+	"github.com/ignite/apps/spaceship/pkg/ssh"
+	"github.com/ignite/apps/spaceship/pkg/tarball"
+)
+
+const (
+	flagSnapshotName = "name"
+	flagSnapshotFile = "file"
+	flagKeep         = "keep"
+)
+
+// ExecuteSSHSnapshotCreate executes the ssh snapshot create subcommand.
+func ExecuteSSHSnapshotCreate(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	var (
+		flags       = plugin.Flags(cmd.Flags)
+		name, _     = flags.GetString(flagSnapshotName)
+		download, _ = flags.GetBool(flagDownload)
+	)
+
+	c, err := executeSSH(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if !c.HasRunnerScript(ctx) {
+		return ErrServerNotInitialized
+	}
+
+	chainStop, err := c.Stop(ctx)
+	if err != nil {
+		return err
+	}
+	_ = session.Println(chainStop)
+
+	session.StartSpinner("Creating snapshot...")
+	snapshot, err := c.CreateSnapshot(ctx, name)
+	if err != nil {
+		// start the chain again, a failed snapshot must not leave the node stopped.
+		if _, startErr := c.Start(ctx); startErr != nil {
+			return errors.Join(err, startErr)
+		}
+		return err
+	}
+	session.StopSpinner()
+	_ = session.Println(color.Yellow.Sprintf("Snapshot '%s' created (%s)", snapshot.Name, humanize.Bytes(uint64(snapshot.Size))))
+
+	chainStart, err := c.Start(ctx)
+	if err != nil {
+		return err
+	}
+	_ = session.Println(chainStart)
+
+	if !download {
+		return nil
+	}
+
+	session.StartSpinner("Downloading snapshot...")
+	path := snapshot.Name + ".tar.gz"
+	if err := c.DownloadSnapshot(snapshot.Name, path); err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+	if err := c.RemoveSnapshot(snapshot.Name); err != nil {
+		return err
+	}
+	session.StopSpinner()
+	return session.Println(color.Yellow.Sprintf("Snapshot downloaded to '%s'", path))
+}
+
+// ExecuteSSHSnapshotList executes the ssh snapshot list subcommand.
+func ExecuteSSHSnapshotList(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	c, err := executeSSH(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if !c.HasRunnerScript(ctx) {
+		return ErrServerNotInitialized
+	}
+
+	snapshots, err := c.ListSnapshots()
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return session.Println("No snapshots found")
+	}
+
+	rows := make([][]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		rows = append(rows, []string{
+			snapshot.Name,
+			humanize.Bytes(uint64(snapshot.Size)),
+			snapshot.Time.Format(time.DateTime),
+		})
+	}
+	return session.PrintTable([]string{"Name", "Size", "Created"}, rows...)
+}
+
+// ExecuteSSHSnapshotRestore executes the ssh snapshot restore subcommand.
+func ExecuteSSHSnapshotRestore(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	var (
+		flags   = plugin.Flags(cmd.Flags)
+		name, _ = flags.GetString(flagSnapshotName)
+		file, _ = flags.GetString(flagSnapshotFile)
+	)
+	if (name == "") == (file == "") {
+		return errors.Errorf("use either the --%s or the --%s flag to restore a snapshot", flagSnapshotName, flagSnapshotFile)
+	}
+
+	c, err := executeSSH(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if !c.HasRunnerScript(ctx) {
+		return ErrServerNotInitialized
+	}
+
+	// stage the state while the chain is still running, the stored snapshots are
+	// extracted on the server and the local tarballs are uploaded.
+	if name != "" {
+		session.StartSpinner("Extracting snapshot...")
+		if err := c.ExtractSnapshot(ctx, name); err != nil {
+			return err
+		}
+		session.StopSpinner()
+	} else if err := uploadSnapshotFile(ctx, session, c, file); err != nil {
+		return err
+	}
+
+	chainStop, err := c.Stop(ctx)
+	if err != nil {
+		return err
+	}
+	_ = session.Println(chainStop)
+
+	if err := c.RestoreState(ctx); err != nil {
+		if _, startErr := c.Start(ctx); startErr != nil {
+			return errors.Join(err, startErr)
+		}
+		return err
+	}
+
+	chainStart, err := c.Start(ctx)
+	if err != nil {
+		return err
+	}
+	_ = session.Println(chainStart)
+	if name == "" {
+		name = file
+	}
+	return session.Println(color.Yellow.Sprintf("Snapshot '%s' restored", name))
+}
+
+// uploadSnapshotFile extracts the local snapshot tarball and uploads its state to the server.
+func uploadSnapshotFile(ctx context.Context, session *cliui.Session, c *ssh.SSH, file string) error {
+	localDir, err := os.MkdirTemp(os.TempDir(), "spaceship")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(localDir)
+
+	// extract the snapshot before touching the remote home, so an invalid
+	// archive doesn't leave the node without state.
+	session.StartSpinner("Extracting snapshot...")
+	localHome := filepath.Join(localDir, "home")
+	extracted, err := tarball.ExtractFile(ctx, file, localHome, ssh.SnapshotFolders()...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to extract snapshot %s", file)
+	}
+	if len(extracted) == 0 {
+		return errors.Errorf("snapshot %s has no %v files", file, ssh.SnapshotFolders())
+	}
+	session.StopSpinner()
+
+	bar, progressCallback := uploadProgress()
+	bar.Describe("Uploading snapshot")
+	if err := c.UploadState(ctx, localHome, progressCallback); err != nil {
+		return err
+	}
+	return session.Println()
+}
+
+// ExecuteSSHSnapshotPrune executes the ssh snapshot prune subcommand.
+func ExecuteSSHSnapshotPrune(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	keep, err := plugin.Flags(cmd.Flags).GetInt(flagKeep)
+	if err != nil {
+		return err
+	}
+	if keep < 0 {
+		return errors.Errorf("invalid number of snapshots to keep: %d", keep)
+	}
+
+	c, err := executeSSH(session, cmd, chain)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if !c.HasRunnerScript(ctx) {
+		return ErrServerNotInitialized
+	}
+
+	snapshots, err := c.ListSnapshots()
+	if err != nil {
+		return err
+	}
+	if len(snapshots) <= keep {
+		return session.Println("No snapshots to prune")
+	}
+
+	// the snapshots are sorted by creation time, remove the oldest ones.
+	pruned := snapshots[:len(snapshots)-keep]
+	for _, snapshot := range pruned {
+		if err := c.RemoveSnapshot(snapshot.Name); err != nil {
+			return err
+		}
+		_ = session.Printf("Snapshot '%s' removed\n", snapshot.Name)
+	}
+	return session.Println(color.Yellow.Sprintf("%d snapshots pruned", len(pruned)))
+}
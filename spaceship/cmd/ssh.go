@@ -43,7 +43,8 @@ func executeSSH(session *cliui.Session, cmd *plugin.ExecutedCommand, chain *plug
 	)
 
 	// Connect to the SSH.
-	c, err := ssh.New(
+	return connectSSH(
+		session,
 		host,
 		ssh.WithUser(user),
 		ssh.WithPort(port),
@@ -53,17 +54,23 @@ func executeSSH(session *cliui.Session, cmd *plugin.ExecutedCommand, chain *plug
 		ssh.WithKeyPassword(keyPassword),
 		ssh.WithWorkspace(chain.ChainId),
 	)
+}
+
+// connectSSH connects to the SSH host, asking for the user password if needed.
+func connectSSH(session *cliui.Session, host string, options ...ssh.Option) (*ssh.SSH, error) {
+	c, err := ssh.New(host, options...)
 	if err != nil {
 		return nil, err
 	}
 
 	if c.NeedsUserPassword() {
+		var password string
 		time.Sleep(10 * time.Millisecond) // Give some time to the spinner to start
 		restart := session.PauseSpinner()
 		if err := session.Ask(
 			bubbleconfirm.NewQuestion(
 				"Enter the SSH user password: ",
-				&userPassword,
+				&password,
 				bubbleconfirm.Required(),
 				bubbleconfirm.HideAnswer(),
 			),
@@ -71,6 +78,9 @@ func executeSSH(session *cliui.Session, cmd *plugin.ExecutedCommand, chain *plug
 			return nil, errors.Wrap(err, "you must provide a password")
 		}
 		restart()
+		if err := ssh.WithUserPassword(password)(c); err != nil {
+			return nil, err
+		}
 	}
 
 	return c, c.Connect()
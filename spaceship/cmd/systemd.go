@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+
+	"github.com/ignite/apps/spaceship/pkg/ssh"
+	"github.com/ignite/apps/spaceship/templates/systemd"
+)
+
+const flagSystemd = "systemd"
+
+// setupSystemd installs the chain and faucet systemd user units if the systemd mode is enabled,
+// stopping the processes started by the runner scripts, or removes the units installed by a
+// previous deployment otherwise.
+func setupSystemd(
+	ctx context.Context,
+	session *cliui.Session,
+	c *ssh.SSH,
+	enabled bool,
+	chainBinPath,
+	faucetBin,
+	cosmovisorBin,
+	account,
+	denom,
+	localDir string,
+	progressCallback ssh.ProgressCallback,
+) error {
+	installed := c.HasSystemd(ctx)
+	if !enabled {
+		if !installed {
+			return nil
+		}
+		_ = session.Println(color.Yellow.Sprint("Removing the chain systemd units"))
+		return c.UninstallSystemd(ctx)
+	}
+
+	if !installed {
+		if _, err := c.Stop(ctx); err != nil {
+			return err
+		}
+		if _, err := c.FaucetStop(ctx); err != nil {
+			return err
+		}
+	}
+
+	unitsDir := filepath.Join(localDir, "systemd")
+	if err := systemd.NewUnits(
+		c.Workspace(),
+		c.Home(),
+		c.Bin(),
+		chainBinPath,
+		faucetBin,
+		cosmovisorBin,
+		c.ChainUnit(),
+		account,
+		denom,
+		unitsDir,
+	); err != nil {
+		return err
+	}
+	if err := c.InstallSystemd(ctx, unitsDir, progressCallback); err != nil {
+		return err
+	}
+	_ = session.Println()
+	_ = session.Println(color.Yellow.Sprintf("Installed the systemd units %s and %s", c.ChainUnit(), c.FaucetUnit()))
+
+	if err := c.EnableLinger(ctx); err != nil {
+		_ = session.Println(color.Red.Sprintf(
+			"Failed to enable the user lingering, the chain will stop when the user logs out and not start at boot: %s",
+			err,
+		))
+	}
+	return nil
+}
@@ -0,0 +1,367 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/gookit/color"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/plugin"
+	"github.com/schollz/progressbar/v3"
+
+	"github.com/ignite/apps/spaceship/pkg/ssh"
+)
+
+const (
+	flagCosmovisor    = "cosmovisor"
+	flagUpgradeName   = "name"
+	flagUpgradeHeight = "height"
+	flagDeposit       = "deposit"
+	flagWait          = "wait"
+	flagWaitTimeout   = "wait-timeout"
+
+	upgradePollInterval = 5 * time.Second
+)
+
+// upgradeNode represents a node to upgrade, with the key voting the upgrade proposal if it is a validator.
+type upgradeNode struct {
+	name   string
+	client *ssh.SSH
+	key    string
+}
+
+// setupCosmovisor uploads the cosmovisor binary and, for a new chain, the chain binary as the cosmovisor
+// genesis binary. The next chain binaries are cosmovisor upgrades uploaded by the upgrade command. It
+// returns the remote cosmovisor binary path, or an empty path if the cosmovisor mode is disabled.
+func setupCosmovisor(
+	ctx context.Context,
+	session *cliui.Session,
+	c *ssh.SSH,
+	enabled,
+	initHome bool,
+	target,
+	chainBin string,
+	bar *progressbar.ProgressBar,
+	progressCallback ssh.ProgressCallback,
+) (string, error) {
+	if !enabled {
+		return "", nil
+	}
+
+	bar.Describe("Uploading cosmovisor binary")
+	cosmovisorBin, err := c.UploadCosmovisor(ctx, target, progressCallback)
+	if err != nil {
+		return "", err
+	}
+	_ = session.Println()
+
+	if !initHome && c.HasCosmovisor(ctx) {
+		_ = session.Println(color.Yellow.Sprint(
+			"The chain binary run by cosmovisor is kept, use the upgrade command to upgrade the chain",
+		))
+		return cosmovisorBin, nil
+	}
+
+	// a new chain starts again from the genesis binary.
+	if err := c.RemoveCosmovisor(ctx); err != nil {
+		return "", err
+	}
+	bar.Describe("Uploading cosmovisor genesis binary")
+	if _, err := c.UploadGenesisBinary(ctx, chainBin, progressCallback); err != nil {
+		return "", err
+	}
+	_ = session.Println()
+	return cosmovisorBin, nil
+}
+
+// ExecuteSSHUpgrade executes the ssh upgrade subcommand.
+func ExecuteSSHUpgrade(ctx context.Context, cmd *plugin.ExecutedCommand, chain *plugin.ChainInfo) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusConnecting))
+	defer session.End()
+
+	var (
+		flags       = plugin.Flags(cmd.Flags)
+		name, _     = flags.GetString(flagUpgradeName)
+		height, _   = flags.GetUint64(flagUpgradeHeight)
+		deposit, _  = flags.GetString(flagDeposit)
+		wait, _     = flags.GetBool(flagWait)
+		timeout, _  = flags.GetString(flagWaitTimeout)
+		specPath, _ = flags.GetString(flagSpec)
+		binName     = fmt.Sprintf("%sd", chain.ChainId)
+	)
+	if name == "" {
+		return errors.Errorf("the upgrade name is required, use the --%s flag", flagUpgradeName)
+	}
+	waitTimeout, err := time.ParseDuration(timeout)
+	if err != nil {
+		return errors.Wrapf(err, "invalid --%s duration %s", flagWaitTimeout, timeout)
+	}
+
+	localDir, err := os.MkdirTemp(os.TempDir(), "spaceship")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(localDir)
+	}()
+
+	chainCfg, err := chainConfig(chain)
+	if err != nil {
+		return err
+	}
+	validatorKey := ""
+	if len(chainCfg.Validators) > 0 {
+		validatorKey = chainCfg.Validators[0].Name
+	}
+
+	nodes := make([]upgradeNode, 0)
+	if specPath != "" {
+		spec, fleetNodes, err := connectFleet(session, cmd, chain)
+		if err != nil {
+			return err
+		}
+		defer closeFleet(fleetNodes)
+
+		// the first validator uses the chain config validator key, the
+		// other validator keys are named after their node.
+		firstValidator := spec.Validators()[0].Name
+		for _, node := range fleetNodes {
+			key := ""
+			switch {
+			case node.Name == firstValidator:
+				key = validatorKey
+			case node.IsValidator():
+				key = node.Name
+			}
+			nodes = append(nodes, upgradeNode{name: node.Name, client: node.client, key: key})
+		}
+	} else {
+		c, err := executeSSH(session, cmd, chain)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		nodes = append(nodes, upgradeNode{name: cmd.Args[0], client: c, key: validatorKey})
+	}
+
+	var (
+		targets     = make([]string, 0)
+		nodeTargets = make(map[string]string)
+	)
+	for _, node := range nodes {
+		if !node.client.HasCosmovisor(ctx) {
+			return errors.Errorf(
+				"node %s chain is not run by cosmovisor, deploy it with the --%s flag",
+				node.name,
+				flagCosmovisor,
+			)
+		}
+		target, err := node.client.Target(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get node %s target", node.name)
+		}
+		nodeTargets[node.name] = target
+		if !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+
+	binaries, err := buildChainBinaries(ctx, session, chain, filepath.Join(localDir, "bin"), targets)
+	if err != nil {
+		return err
+	}
+
+	bar, progressCallback := uploadProgress()
+	for _, node := range nodes {
+		bar.Describe(fmt.Sprintf("Uploading node %s upgrade binary", node.name))
+		binPath, err := node.client.UploadUpgradeBinary(ctx, name, binaries[nodeTargets[node.name]], progressCallback)
+		if err != nil {
+			return err
+		}
+		_ = session.Println()
+		_ = session.Println(color.Yellow.Sprintf("Upgrade %s binary uploaded to '%s'", name, binPath))
+		_ = session.Println(color.Yellow.Sprintf("Node %s: %s", node.name, node.client.Transfers()))
+	}
+
+	// the proposal is submitted from the first validator node.
+	proposer := nodes[0]
+	for _, node := range nodes {
+		if node.key != "" {
+			proposer = node
+			break
+		}
+	}
+	if height > 0 {
+		if deposit == "" {
+			if deposit, err = proposer.client.MinDeposit(ctx, binName); err != nil {
+				return err
+			}
+		}
+
+		session.StartSpinner(fmt.Sprintf("Submitting the upgrade %s proposal...", name))
+		id, err := proposer.client.SubmitUpgradeProposal(
+			ctx,
+			binName,
+			chain.ChainId,
+			proposer.key,
+			name,
+			int64(height),
+			deposit,
+		)
+		if err != nil {
+			return err
+		}
+
+		voters := 0
+		for _, node := range nodes {
+			if node.key == "" {
+				continue
+			}
+			session.StartSpinner(fmt.Sprintf("Voting the upgrade proposal %d from %s...", id, node.name))
+			if err := node.client.VoteProposal(ctx, binName, chain.ChainId, node.key, id); err != nil {
+				return errors.Wrapf(err, "failed to vote the upgrade proposal from %s", node.name)
+			}
+			voters++
+		}
+		session.StopSpinner()
+		_ = session.Println(color.Yellow.Sprintf(
+			"Upgrade proposal %d submitted for the height %d and voted by %d validators",
+			id,
+			height,
+			voters,
+		))
+	}
+
+	if wait {
+		if height == 0 {
+			planName, planHeight, err := proposer.client.UpgradePlan(ctx, binName)
+			if err != nil {
+				return err
+			}
+			switch {
+			case planHeight == 0:
+				return errors.New("no upgrade scheduled, use the --height flag to submit an upgrade proposal")
+			case planName != name:
+				return errors.Errorf("the scheduled upgrade is %s, not %s", planName, name)
+			}
+			height = uint64(planHeight)
+		}
+		if err := waitUpgrade(ctx, session, nodes, binName, name, int64(height), waitTimeout); err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		if err := printUpgradeVersions(session, node.name, node.client); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitUpgrade waits for the chain to reach the upgrade height and for cosmovisor to
+// run the upgrade binary on all the nodes. It fails if the chain goes past the upgrade
+// height without upgrading, e.g. if the proposal was rejected, or after the timeout.
+func waitUpgrade(
+	ctx context.Context,
+	session *cliui.Session,
+	nodes []upgradeNode,
+	binName,
+	name string,
+	height int64,
+	timeout time.Duration,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	session.StartSpinner(fmt.Sprintf("Waiting for the upgrade height %d...", height))
+	ticker := time.NewTicker(upgradePollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		select {
+		case <-ctx.Done():
+			err := errors.Errorf("timeout waiting for the upgrade %s at height %d after %s", name, height, timeout)
+			if lastErr != nil {
+				err = errors.Join(err, lastErr)
+			}
+			return err
+		case <-ticker.C:
+		}
+
+		// the node is not reachable while cosmovisor switches the binaries.
+		current, err := nodes[0].client.BlockHeight(ctx, binName)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		upgraded := 0
+		for _, node := range nodes {
+			versions, err := node.client.UpgradeVersions()
+			if err != nil {
+				return err
+			}
+			for _, version := range versions {
+				if version.Current && version.Name == name {
+					upgraded++
+				}
+			}
+		}
+		if current >= height && upgraded == len(nodes) {
+			session.StopSpinner()
+			_ = session.Println(color.Blue.Sprintf("Chain upgraded to %s at height %d", name, height))
+			return nil
+		}
+
+		// the chain halts at the upgrade height until the upgrade binary runs, blocks
+		// past this height without any node upgraded mean the upgrade didn't happen.
+		if current > height && upgraded == 0 {
+			planName, planHeight, err := nodes[0].client.UpgradePlan(ctx, binName)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if planName != name || planHeight <= height {
+				return errors.Errorf(
+					"the chain is at height %d but the upgrade %s didn't happen at height %d, check the proposal passed",
+					current,
+					name,
+					height,
+				)
+			}
+		}
+		session.StartSpinner(fmt.Sprintf(
+			"Waiting for the upgrade height %d, current height %d, %d of %d nodes upgraded...",
+			height,
+			current,
+			upgraded,
+			len(nodes),
+		))
+	}
+}
+
+// printUpgradeVersions prints the chain binary versions managed by cosmovisor of a node.
+func printUpgradeVersions(session *cliui.Session, name string, c *ssh.SSH) error {
+	versions, err := c.UpgradeVersions()
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(versions))
+	for _, version := range versions {
+		current := ""
+		if version.Current {
+			current = "*"
+		}
+		rows = append(rows, []string{version.Name, version.Time.Format(time.DateTime), current})
+	}
+	_ = session.Println(color.Yellow.Sprintf("\n%s chain versions:", name))
+	return session.PrintTable([]string{"Version", "Uploaded", "Current"}, rows...)
+}
@@ -37,6 +37,8 @@ func (app) Execute(ctx context.Context, c *plugin.ExecutedCommand, api plugin.Cl
 		return cmd.ExecuteSSHRestart(ctx, c, chainInfo)
 	case "stop":
 		return cmd.ExecuteSSHSStop(ctx, c, chainInfo)
+	case "upgrade":
+		return cmd.ExecuteSSHUpgrade(ctx, c, chainInfo)
 	case "log":
 		return cmd.ExecuteSSHLog(ctx, c, chainInfo)
 	case "faucet":
@@ -52,6 +54,19 @@ func (app) Execute(ctx context.Context, c *plugin.ExecutedCommand, api plugin.Cl
 		default:
 			return fmt.Errorf("unknown faucet command: %s", args[1])
 		}
+	case "snapshot":
+		switch args[1] {
+		case "create":
+			return cmd.ExecuteSSHSnapshotCreate(ctx, c, chainInfo)
+		case "list":
+			return cmd.ExecuteSSHSnapshotList(ctx, c, chainInfo)
+		case "restore":
+			return cmd.ExecuteSSHSnapshotRestore(ctx, c, chainInfo)
+		case "prune":
+			return cmd.ExecuteSSHSnapshotPrune(ctx, c, chainInfo)
+		default:
+			return fmt.Errorf("unknown snapshot command: %s", args[1])
+		}
 	default:
 		return fmt.Errorf("unknown command: %s", args[0])
 	}
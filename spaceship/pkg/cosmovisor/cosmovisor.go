@@ -0,0 +1,115 @@
+// Package cosmovisor provides the cosmovisor binary used to run the chain and
+// swap its binary at the governance upgrade heights.
+package cosmovisor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/ignite/cli/v29/ignite/config"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
+	"github.com/ignite/cli/v29/ignite/pkg/xos"
+
+	"github.com/ignite/apps/spaceship/pkg/tarball"
+)
+
+const (
+	cosmovisorBinaryName  = "cosmovisor"
+	binaryCacheDirectory  = "apps/spaceship/bin"
+	cosmovisorLastRelease = "https://github.com/cosmos/cosmos-sdk/releases/download"
+)
+
+// cosmovisorVersion specifies the current version of the cosmovisor binary.
+var cosmovisorVersion = semver.MustParse("1.7.1")
+
+// releaseTarget converts a build target (e.g. linux:amd64 or linux_amd64) into the release target format.
+func releaseTarget(target string) string {
+	return strings.NewReplacer(":", "-", "_", "-").Replace(target)
+}
+
+// cosmovisorReleaseName constructs the download URL for a cosmovisor binary tarball given the target platform.
+func cosmovisorReleaseName(target string) string {
+	return fmt.Sprintf(
+		"%[1]v/%[2]v/cosmovisor-v%[3]v-%[4]v.tar.gz",
+		cosmovisorLastRelease,
+		url.PathEscape("cosmovisor/v"+cosmovisorVersion.String()),
+		cosmovisorVersion.String(),
+		releaseTarget(target),
+	)
+}
+
+// FetchBinary downloads the cosmovisor binary for a specific target
+// and caches it locally if not already cached.
+func FetchBinary(ctx context.Context, target string) (string, error) {
+	binPath, err := binCachePath(target)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if the binary already exists in the ignite cache.
+	if _, err := os.Stat(binPath); err == nil {
+		return binPath, nil
+	}
+
+	// Create a temporary folder to extract the cosmovisor binary.
+	tempDir, err := os.MkdirTemp("", "cosmovisor")
+	if err != nil {
+		return "", errors.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	binaryURL := cosmovisorReleaseName(target)
+
+	// Download the binary.
+	client := &http.Client{
+		Timeout: 60 * time.Second,
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, binaryURL, nil)
+	if err != nil {
+		return "", errors.Errorf("failed to build cosmovisor download request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", errors.Errorf("failed to download cosmovisor binary: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to fetch cosmovisor binary: %s status", resp.Status)
+	}
+
+	// Extract the binary tarball.
+	extracted, err := tarball.ExtractData(ctx, resp.Body, tempDir, cosmovisorBinaryName)
+	if err != nil {
+		return "", err
+	}
+	if len(extracted) == 0 {
+		return "", errors.Errorf("zero files extracted from %s cosmovisor the tarball: %s", target, binaryURL)
+	}
+
+	return binPath, xos.Rename(extracted[0], binPath)
+}
+
+// BinaryName generates the binary name by concatenating the cosmovisor binary name and version.
+func BinaryName() string {
+	return fmt.Sprintf("%s_%s", cosmovisorBinaryName, cosmovisorVersion.String())
+}
+
+// binCachePath constructs the full path to the cached binary of the target and ensures the
+// necessary directories exist.
+func binCachePath(target string) (string, error) {
+	dirPath, err := xfilepath.Join(config.DirPath, xfilepath.Path(binaryCacheDirectory))()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dirPath, fmt.Sprintf("%s_%s", BinaryName(), releaseTarget(target)))
+	return path, os.MkdirAll(dirPath, 0o755)
+}
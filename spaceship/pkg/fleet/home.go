@@ -0,0 +1,195 @@
+package fleet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+
+	"github.com/ignite/apps/spaceship/pkg/nodeconfig"
+)
+
+const keyringBackend = "test"
+
+// GenerateHomes generates the home of each fleet node sharing the same genesis, using the
+// local chain binary. The base home initialized by Ignite is used as the first validator home,
+// the other validators are initialized into the output folder with their own gentx and the
+// genesis accounts bonding the node bonded amount, or the default bonded amount if not set.
+// The p2p settings of each home are set from the fleet spec. The faucet nodes share the
+// base home keyring, holding the chain accounts. It returns the home path of each node.
+func GenerateHomes(ctx context.Context, spec Spec, bin, baseHome, output, bonded string) (map[string]string, error) {
+	chainID, err := genesisChainID(baseHome)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		validators = spec.Validators()
+		homes      = map[string]string{validators[0].Name: baseHome}
+		gentxDir   = filepath.Join(baseHome, "config", "gentx")
+	)
+	for _, node := range validators[1:] {
+		home := filepath.Join(output, node.Name)
+		homes[node.Name] = home
+
+		nodeBonded := node.Bonded
+		if nodeBonded == "" {
+			nodeBonded = bonded
+		}
+		if _, err := run(ctx, bin, "init", node.Name, "--chain-id", chainID, "--home", home); err != nil {
+			return nil, err
+		}
+		if _, err := run(ctx, bin, "keys", "add", node.Name, "--keyring-backend", keyringBackend, "--home", home); err != nil {
+			return nil, err
+		}
+		address, err := run(ctx, bin, "keys", "show", node.Name, "-a", "--keyring-backend", keyringBackend, "--home", home)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := run(ctx, bin, "genesis", "add-genesis-account", address, nodeBonded, "--home", baseHome); err != nil {
+			return nil, err
+		}
+
+		// the gentx is signed against the genesis holding the validator account
+		if err := copyFile(genesisPath(baseHome), genesisPath(home)); err != nil {
+			return nil, err
+		}
+		if _, err := run(
+			ctx,
+			bin,
+			"genesis",
+			"gentx",
+			node.Name,
+			nodeBonded,
+			"--chain-id", chainID,
+			"--moniker", node.Name,
+			"--keyring-backend", keyringBackend,
+			"--home", home,
+			"--output-document", filepath.Join(gentxDir, fmt.Sprintf("gentx-%s.json", node.Name)),
+		); err != nil {
+			return nil, err
+		}
+	}
+	if len(validators) > 1 {
+		if _, err := run(ctx, bin, "genesis", "collect-gentxs", "--home", baseHome); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, node := range spec.Nodes {
+		if node.IsValidator() {
+			continue
+		}
+		home := filepath.Join(output, node.Name)
+		homes[node.Name] = home
+		if _, err := run(ctx, bin, "init", node.Name, "--chain-id", chainID, "--home", home); err != nil {
+			return nil, err
+		}
+		if node.IsFaucet() {
+			keyringDir := fmt.Sprintf("keyring-%s", keyringBackend)
+			if err := os.CopyFS(filepath.Join(home, keyringDir), os.DirFS(filepath.Join(baseHome, keyringDir))); err != nil {
+				return nil, errors.Wrapf(err, "failed to copy the faucet node %s keyring", node.Name)
+			}
+		}
+	}
+
+	// distribute the final genesis and wire the nodes.
+	nodeIDs := make(map[string]string)
+	for name, home := range homes {
+		if home != baseHome {
+			if err := copyFile(genesisPath(baseHome), genesisPath(home)); err != nil {
+				return nil, err
+			}
+		}
+		nodeKey, err := p2p.LoadNodeKey(filepath.Join(home, "config", "node_key.json"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load the node %s key", name)
+		}
+		nodeIDs[name] = string(nodeKey.ID())
+	}
+
+	p2pConfigs, err := spec.P2PConfigs(nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	for name, home := range homes {
+		if err := setConfigValues(home, "p2p", p2pConfigs[name].Values()); err != nil {
+			return nil, err
+		}
+	}
+	return homes, nil
+}
+
+// genesisPath returns the genesis file path of the home.
+func genesisPath(home string) string {
+	return filepath.Join(home, "config", "genesis.json")
+}
+
+// genesisChainID returns the chain id from the home genesis.
+func genesisChainID(home string) (string, error) {
+	data, err := os.ReadFile(genesisPath(home))
+	if err != nil {
+		return "", err
+	}
+	var genesis struct {
+		ChainID string `json:"chain_id"`
+	}
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return "", errors.Wrapf(err, "failed to decode genesis %s", genesisPath(home))
+	}
+	if genesis.ChainID == "" {
+		return "", errors.Errorf("genesis %s has no chain id", genesisPath(home))
+	}
+	return genesis.ChainID, nil
+}
+
+// setConfigValues sets the values of a section from the home config.toml.
+func setConfigValues(home, section string, values map[string]string) error {
+	path := filepath.Join(home, "config", "config.toml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	data, err = nodeconfig.SetTOMLValues(data, section, values)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update %s", path)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// copyFile copies a file, creating the destination folder if needed.
+func copyFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0o644)
+}
+
+// run runs the chain binary and returns its trimmed standard output.
+func run(ctx context.Context, bin string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf(
+			"%s: failed to run %s %s\n%s",
+			err.Error(),
+			filepath.Base(bin),
+			strings.Join(args, " "),
+			strings.TrimSpace(stderr.String()),
+		)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
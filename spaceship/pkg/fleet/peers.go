@@ -0,0 +1,79 @@
+package fleet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// P2PConfig represents the CometBFT p2p settings of a node.
+type P2PConfig struct {
+	PersistentPeers []string
+	PrivatePeerIDs  []string
+	PEX             bool
+}
+
+// P2PConfigs returns the p2p settings of each node from the node ids. Without sentries all
+// the nodes are connected to each other. With sentries, the validators are only connected to
+// the sentries, with the peer exchange disabled, and the sentries keep the validators private.
+func (s Spec) P2PConfigs(nodeIDs map[string]string) (map[string]P2PConfig, error) {
+	peers := make(map[string]string)
+	for _, node := range s.Nodes {
+		id, ok := nodeIDs[node.Name]
+		if !ok {
+			return nil, errors.Errorf("node %s id not found", node.Name)
+		}
+		addr, err := node.P2PAddr()
+		if err != nil {
+			return nil, err
+		}
+		peers[node.Name] = fmt.Sprintf("%s@%s", id, addr)
+	}
+
+	var (
+		hasSentries  = len(s.Sentries()) > 0
+		validatorIDs = make([]string, 0)
+	)
+	for _, node := range s.Validators() {
+		validatorIDs = append(validatorIDs, nodeIDs[node.Name])
+	}
+
+	configs := make(map[string]P2PConfig)
+	for _, node := range s.Nodes {
+		cfg := P2PConfig{PEX: true}
+		for _, other := range s.Nodes {
+			if other.Name == node.Name {
+				continue
+			}
+			// behind sentries, the validators are only connected to the sentries
+			if hasSentries && (node.IsValidator() && other.Role != RoleSentry ||
+				other.IsValidator() && node.Role != RoleSentry) {
+				continue
+			}
+			cfg.PersistentPeers = append(cfg.PersistentPeers, peers[other.Name])
+		}
+		if hasSentries {
+			switch node.Role {
+			case RoleValidator:
+				cfg.PEX = false
+			case RoleSentry:
+				cfg.PrivatePeerIDs = validatorIDs
+			}
+		}
+		configs[node.Name] = cfg
+	}
+	return configs, nil
+}
+
+// Values returns the p2p settings as config.toml values.
+func (c P2PConfig) Values() map[string]string {
+	return map[string]string{
+		"persistent_peers": strconv.Quote(strings.Join(c.PersistentPeers, ",")),
+		"private_peer_ids": strconv.Quote(strings.Join(c.PrivatePeerIDs, ",")),
+		"pex":              strconv.FormatBool(c.PEX),
+		// the fleet nodes might be in a private network
+		"addr_book_strict": "false",
+	}
+}
@@ -0,0 +1,37 @@
+package fleet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestP2PConfigs(t *testing.T) {
+	var (
+		spec = Spec{Nodes: []Node{
+			{Name: "val-1", Host: "10.0.0.1", Role: RoleValidator},
+			{Name: "val-2", Host: "10.0.0.2", Role: RoleValidator},
+			{Name: "sentry-1", Host: "10.0.0.3", Role: RoleSentry, P2PAddress: "sentry.example.com:26656"},
+			{Name: "faucet-1", Host: "10.0.0.4", Role: RoleFaucet},
+		}}
+		nodeIDs = map[string]string{"val-1": "id1", "val-2": "id2", "sentry-1": "id3", "faucet-1": "id4"}
+	)
+
+	configs, err := spec.P2PConfigs(nodeIDs)
+	require.NoError(t, err)
+	require.Equal(t, map[string]P2PConfig{
+		"val-1":    {PersistentPeers: []string{"id3@sentry.example.com:26656"}},
+		"val-2":    {PersistentPeers: []string{"id3@sentry.example.com:26656"}},
+		"sentry-1": {PersistentPeers: []string{"id1@10.0.0.1:26656", "id2@10.0.0.2:26656", "id4@10.0.0.4:26656"}, PrivatePeerIDs: []string{"id1", "id2"}, PEX: true},
+		"faucet-1": {PersistentPeers: []string{"id3@sentry.example.com:26656"}, PEX: true},
+	}, configs)
+
+	// without sentries, all the nodes are connected to each other.
+	spec.Nodes = append(spec.Nodes[:2], spec.Nodes[3])
+	configs, err = spec.P2PConfigs(nodeIDs)
+	require.NoError(t, err)
+	require.Equal(t, P2PConfig{PersistentPeers: []string{"id2@10.0.0.2:26656", "id4@10.0.0.4:26656"}, PEX: true}, configs["val-1"])
+
+	_, err = spec.P2PConfigs(map[string]string{"val-1": "id1"})
+	require.EqualError(t, err, "node val-2 id not found")
+}
@@ -0,0 +1,236 @@
+// Package fleet provides the deployment spec of a multi-host chain, with its
+// nodes roles, and the generation of the node homes sharing the same genesis.
+package fleet
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/apps/spaceship/pkg/nodeconfig"
+)
+
+// DefaultP2PPort is the default CometBFT p2p port.
+const DefaultP2PPort = "26656"
+
+// Role represents the role of a node in the fleet.
+type Role string
+
+const (
+	// RoleValidator is a node signing blocks with its own validator key.
+	RoleValidator Role = "validator"
+	// RoleFullNode is a non-validator node syncing the chain.
+	RoleFullNode Role = "full"
+	// RoleSentry is a full node shielding the validators from the public network.
+	RoleSentry Role = "sentry"
+	// RoleFaucet is a full node also running the chain faucet.
+	RoleFaucet Role = "faucet"
+)
+
+// Roles returns the valid node roles.
+func Roles() []string {
+	return []string{RoleValidator.String(), RoleFullNode.String(), RoleSentry.String(), RoleFaucet.String()}
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+type (
+	// Spec represents a deployment spec listing the nodes of the fleet.
+	Spec struct {
+		// Config is the node config overlay of all the nodes.
+		Config nodeconfig.Overlay `yaml:"config"`
+		Nodes  []Node             `yaml:"nodes"`
+	}
+
+	// Node represents a fleet node deployed to a SSH host. The SSH settings not
+	// set fall back to the command flags.
+	Node struct {
+		Name        string `yaml:"name"`
+		Host        string `yaml:"host"`
+		Role        Role   `yaml:"role"`
+		User        string `yaml:"user"`
+		Port        string `yaml:"port"`
+		Key         string `yaml:"key"`
+		Password    string `yaml:"password"`
+		KeyPassword string `yaml:"key_password"`
+		// P2PAddress is the address other nodes use to reach the node,
+		// by default the SSH host with the default p2p port.
+		P2PAddress string `yaml:"p2p_address"`
+		// Bonded is the validator self-delegation, by default the one of
+		// the first validator from the chain config.
+		Bonded string `yaml:"bonded"`
+		// Config is the node config overlay, overriding the spec one.
+		Config nodeconfig.Overlay `yaml:"config"`
+	}
+)
+
+// LoadSpec loads the deployment spec from a YAML file.
+func LoadSpec(path string) (Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Spec{}, err
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return Spec{}, errors.Wrapf(err, "failed to decode deployment spec %s", path)
+	}
+	for i, node := range spec.Nodes {
+		spec.Nodes[i].Role = Role(strings.ToLower(node.Role.String()))
+	}
+	return spec, spec.Validate()
+}
+
+// Validate validates the deployment spec.
+func (s Spec) Validate() error {
+	var (
+		names      = make(map[string]struct{})
+		hosts      = make(map[string]string)
+		validators = 0
+	)
+	for _, node := range s.Nodes {
+		switch {
+		case node.Name == "":
+			return errors.New("node name cannot be empty")
+		case node.Host == "":
+			return errors.Errorf("node %s host cannot be empty", node.Name)
+		}
+		switch node.Role {
+		case RoleValidator:
+			validators++
+		case RoleFullNode, RoleSentry, RoleFaucet:
+		default:
+			return errors.Errorf(
+				"invalid node %s role %s, valid options are %s",
+				node.Name,
+				node.Role,
+				strings.Join(Roles(), ", "),
+			)
+		}
+
+		if _, ok := names[node.Name]; ok {
+			return errors.Errorf("duplicated node %s", node.Name)
+		}
+		names[node.Name] = struct{}{}
+
+		// each node uses the chain workspace of its host
+		host, err := node.Hostname()
+		if err != nil {
+			return err
+		}
+		if other, ok := hosts[host]; ok {
+			return errors.Errorf("nodes %s and %s are deployed to the same host %s", other, node.Name, host)
+		}
+		hosts[host] = node.Name
+	}
+	if validators == 0 {
+		return errors.New("the deployment spec must have at least one validator")
+	}
+	return nil
+}
+
+// Validators returns the validator nodes.
+func (s Spec) Validators() []Node {
+	return s.byRole(RoleValidator)
+}
+
+// Sentries returns the sentry nodes.
+func (s Spec) Sentries() []Node {
+	return s.byRole(RoleSentry)
+}
+
+// byRole returns the nodes with the role.
+func (s Spec) byRole(role Role) []Node {
+	nodes := make([]Node, 0)
+	for _, node := range s.Nodes {
+		if node.Role == role {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// IsValidator returns true if the node is a validator.
+func (n Node) IsValidator() bool {
+	return n.Role == RoleValidator
+}
+
+// IsFaucet returns true if the node runs the chain faucet.
+func (n Node) IsFaucet() bool {
+	return n.Role == RoleFaucet
+}
+
+// Hostname returns the node SSH host name, without the user and port.
+func (n Node) Hostname() (string, error) {
+	parsed, err := n.parseHost()
+	if err != nil {
+		return "", err
+	}
+	return parsed.Hostname(), nil
+}
+
+// SSHHost returns the node SSH URI with its user and port. The user and port set in the
+// host take precedence over the node ones, which take precedence over the default ones.
+func (n Node) SSHHost(defaultUser, defaultPort string) (string, error) {
+	parsed, err := n.parseHost()
+	if err != nil {
+		return "", err
+	}
+
+	user := parsed.User.Username()
+	for _, u := range []string{n.User, defaultUser} {
+		if user == "" {
+			user = strings.TrimSpace(u)
+		}
+	}
+	port := parsed.Port()
+	for _, p := range []string{n.Port, defaultPort} {
+		if port == "" {
+			port = strings.TrimSpace(p)
+		}
+	}
+
+	uri := url.URL{Scheme: "ssh", Host: parsed.Hostname()}
+	if port != "" {
+		uri.Host = net.JoinHostPort(uri.Host, port)
+	} else if strings.Contains(uri.Host, ":") {
+		uri.Host = "[" + uri.Host + "]"
+	}
+	if password, ok := parsed.User.Password(); ok {
+		uri.User = url.UserPassword(user, password)
+	} else if user != "" {
+		uri.User = url.User(user)
+	}
+	return uri.String(), nil
+}
+
+// parseHost parses the node SSH host.
+func (n Node) parseHost() (*url.URL, error) {
+	uri := n.Host
+	if !strings.HasPrefix(uri, "ssh://") {
+		uri = "ssh://" + uri
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid node %s host %s", n.Name, n.Host)
+	}
+	return parsed, nil
+}
+
+// P2PAddr returns the node p2p address in the host:port format.
+func (n Node) P2PAddr() (string, error) {
+	if n.P2PAddress != "" {
+		return n.P2PAddress, nil
+	}
+	host, err := n.Hostname()
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, DefaultP2PPort), nil
+}
@@ -0,0 +1,80 @@
+package nodeconfig
+
+import (
+	"strings"
+)
+
+// DiffOp represents the operation of a diff line.
+type DiffOp string
+
+const (
+	DiffRemoved DiffOp = "-"
+	DiffAdded   DiffOp = "+"
+)
+
+// DiffLine represents a removed or added line of a TOML file, with the section it belongs to.
+type DiffLine struct {
+	Op      DiffOp
+	Section string
+	Text    string
+}
+
+func (l DiffLine) String() string {
+	return string(l.Op) + " " + l.Text
+}
+
+// Diff returns the lines removed from and added to the TOML data, using the longest
+// common subsequence of the lines.
+func Diff(oldData, newData []byte) []DiffLine {
+	var (
+		oldLines = strings.Split(string(oldData), "\n")
+		newLines = strings.Split(string(newData), "\n")
+		lcs      = make([][]int, len(oldLines)+1)
+	)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var (
+		diff       = make([]DiffLine, 0)
+		oldSection string
+		newSection string
+		i, j       int
+	)
+	for i < len(oldLines) || j < len(newLines) {
+		switch {
+		case i < len(oldLines) && j < len(newLines) && oldLines[i] == newLines[j]:
+			oldSection = tomlSection(oldLines[i], oldSection)
+			newSection = tomlSection(newLines[j], newSection)
+			i++
+			j++
+		case i < len(oldLines) && (j == len(newLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			oldSection = tomlSection(oldLines[i], oldSection)
+			diff = append(diff, DiffLine{Op: DiffRemoved, Section: oldSection, Text: oldLines[i]})
+			i++
+		default:
+			newSection = tomlSection(newLines[j], newSection)
+			diff = append(diff, DiffLine{Op: DiffAdded, Section: newSection, Text: newLines[j]})
+			j++
+		}
+	}
+	return diff
+}
+
+// tomlSection returns the section of the line if it is a section header, or the current section.
+func tomlSection(line, current string) string {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+		return strings.Trim(trimmed, "[]")
+	}
+	return current
+}
@@ -0,0 +1,290 @@
+// Package nodeconfig patches the app.toml, config.toml and client.toml files of a
+// chain home with the node settings of a deployment.
+package nodeconfig
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultP2PPort is the CometBFT p2p port used if the config.toml p2p address has no port.
+const DefaultP2PPort = "26656"
+
+// File represents a node config file from the home config folder.
+type File string
+
+const (
+	FileApp    File = "app"
+	FileConfig File = "config"
+	FileClient File = "client"
+)
+
+// Files returns the node config files.
+func Files() []File {
+	return []File{FileApp, FileConfig, FileClient}
+}
+
+func (f File) String() string {
+	return string(f)
+}
+
+// Path returns the file path within the home directory.
+func (f File) Path(home string) string {
+	return filepath.Join(home, "config", f.String()+".toml")
+}
+
+// ParseFile parses the config file name.
+func ParseFile(name string) (File, error) {
+	file := File(strings.TrimSuffix(strings.ToLower(name), ".toml"))
+	if !slices.Contains(Files(), file) {
+		return "", errors.Errorf("invalid config file %s, use one of %v", name, Files())
+	}
+	return file, nil
+}
+
+// Overlay represents the TOML encoded values to set into the node config files, by file,
+// section and key. The empty section is the root table of the file.
+//
+// From YAML, each file is a map of keys, where the nested maps are the sections:
+//
+//	app:
+//	  minimum-gas-prices: 0.025stake
+//	  api:
+//	    enable: true
+//	config:
+//	  rpc:
+//	    cors_allowed_origins: ["*"]
+type Overlay map[File]map[string]map[string]string
+
+// Set sets the TOML encoded value of a key.
+func (o Overlay) Set(file File, section, key, value string) {
+	if o[file] == nil {
+		o[file] = make(map[string]map[string]string)
+	}
+	if o[file][section] == nil {
+		o[file][section] = make(map[string]string)
+	}
+	o[file][section][key] = value
+}
+
+// Get returns the TOML encoded value of a key, or false if the key is not set.
+func (o Overlay) Get(file File, section, key string) (string, bool) {
+	value, ok := o[file][section][key]
+	return value, ok
+}
+
+// Merge returns a new overlay with the values of the overlays, the values of the
+// last overlays overriding the previous ones.
+func Merge(overlays ...Overlay) Overlay {
+	result := make(Overlay)
+	for _, overlay := range overlays {
+		for file, sections := range overlay {
+			for section, values := range sections {
+				for key, value := range values {
+					result.Set(file, section, key, value)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// UnmarshalYAML decodes the overlay from the YAML file maps.
+func (o *Overlay) UnmarshalYAML(value *yaml.Node) error {
+	var files map[string]map[string]any
+	if err := value.Decode(&files); err != nil {
+		return err
+	}
+
+	*o = make(Overlay)
+	for name, values := range files {
+		file, err := ParseFile(name)
+		if err != nil {
+			return err
+		}
+		if err := o.setYAML(file, nil, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setYAML sets the values of a YAML map, the nested maps being the sections.
+func (o Overlay) setYAML(file File, section []string, values map[string]any) error {
+	for key, value := range values {
+		if nested, ok := value.(map[string]any); ok {
+			if err := o.setYAML(file, append(slices.Clone(section), key), nested); err != nil {
+				return err
+			}
+			continue
+		}
+		encoded, err := encodeValue(value)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s.toml value %s", file, strings.Join(append(section, key), "."))
+		}
+		o.Set(file, strings.Join(section, "."), key, encoded)
+	}
+	return nil
+}
+
+// LoadFile loads the overlay from a YAML file.
+func LoadFile(path string) (Overlay, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var overlay Overlay
+	if err := yaml.Unmarshal(data, &overlay); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode node config %s", path)
+	}
+	return overlay, nil
+}
+
+// ParseSet parses the overlay from a list of <file>.[<section>.]<key>=<value> settings,
+// e.g. app.api.enable=true. The booleans, numbers, quoted strings and arrays are kept
+// as is, the other values are encoded as strings.
+func ParseSet(settings []string) (Overlay, error) {
+	overlay := make(Overlay)
+	for _, setting := range settings {
+		path, value, ok := strings.Cut(setting, "=")
+		if !ok {
+			return nil, errors.Errorf("invalid config setting %s, use the <file>.[<section>.]<key>=<value> format", setting)
+		}
+		parts := strings.Split(strings.TrimSpace(path), ".")
+		if len(parts) < 2 {
+			return nil, errors.Errorf("invalid config setting %s, the key must be prefixed by the config file", setting)
+		}
+		file, err := ParseFile(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		var (
+			section = strings.Join(parts[1:len(parts)-1], ".")
+			key     = parts[len(parts)-1]
+		)
+		overlay.Set(file, section, key, encodeSetValue(strings.TrimSpace(value)))
+	}
+	return overlay, nil
+}
+
+// encodeSetValue encodes a command line value as a TOML value.
+func encodeSetValue(value string) string {
+	if value == "true" || value == "false" {
+		return value
+	}
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	if strings.HasPrefix(value, `"`) || strings.HasPrefix(value, "'") || strings.HasPrefix(value, "[") {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+// encodeValue encodes a YAML value as a TOML value.
+func encodeValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int64, uint64:
+		return fmt.Sprint(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			encoded, err := encodeValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, encoded)
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	default:
+		return "", errors.Errorf("unsupported value type %T", value)
+	}
+}
+
+// ExternalAddress returns the p2p external address of the node from the host and the
+// p2p listen port of the home config.toml.
+func ExternalAddress(home, host string) (string, error) {
+	data, err := os.ReadFile(FileConfig.Path(home))
+	if err != nil {
+		return "", err
+	}
+
+	port := DefaultP2PPort
+	if laddr, ok := TOMLValue(data, "p2p", "laddr"); ok {
+		laddr, err := strconv.Unquote(laddr)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid p2p laddr %s", laddr)
+		}
+		addr, err := url.Parse(laddr)
+		if err == nil && addr.Port() != "" {
+			port = addr.Port()
+		}
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// Change represents the changes of a node config file.
+type Change struct {
+	File File
+	Diff []DiffLine
+}
+
+// Apply sets the overlay values into the config files of the home directory and
+// returns the changes of each file.
+func (o Overlay) Apply(home string) ([]Change, error) {
+	changes := make([]Change, 0)
+	for _, file := range Files() {
+		sections, ok := o[file]
+		if !ok {
+			continue
+		}
+
+		path := file.Path(home)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		names := make([]string, 0, len(sections))
+		for section := range sections {
+			names = append(names, section)
+		}
+		slices.Sort(names)
+
+		patched := data
+		for _, section := range names {
+			patched, err = SetTOMLValues(patched, section, sections[section])
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to update %s", path)
+			}
+		}
+
+		diff := Diff(data, patched)
+		if len(diff) == 0 {
+			continue
+		}
+		if err := os.WriteFile(path, patched, 0o644); err != nil {
+			return nil, err
+		}
+		changes = append(changes, Change{File: file, Diff: diff})
+	}
+	return changes, nil
+}
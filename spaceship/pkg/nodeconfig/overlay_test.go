@@ -0,0 +1,103 @@
+package nodeconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseSet(t *testing.T) {
+	overlay, err := ParseSet([]string{
+		"app.minimum-gas-prices=0.025stake",
+		"app.api.enable=true",
+		"app.state-sync.snapshot-interval=1000",
+		"config.rpc.cors_allowed_origins=[\"*\"]",
+		"client.toml.node=tcp://localhost:26657",
+	})
+	require.NoError(t, err)
+	require.Equal(t, Overlay{
+		FileApp: {
+			"":           {"minimum-gas-prices": `"0.025stake"`},
+			"api":        {"enable": "true"},
+			"state-sync": {"snapshot-interval": "1000"},
+		},
+		FileConfig: {"rpc": {"cors_allowed_origins": `["*"]`}},
+		FileClient: {"toml": {"node": `"tcp://localhost:26657"`}},
+	}, overlay)
+
+	_, err = ParseSet([]string{"app.pruning"})
+	require.Error(t, err)
+	_, err = ParseSet([]string{"pruning=nothing"})
+	require.Error(t, err)
+	_, err = ParseSet([]string{"genesis.chain_id=test"})
+	require.Error(t, err)
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	var overlay Overlay
+	require.NoError(t, yaml.Unmarshal([]byte(`
+app:
+  minimum-gas-prices: 0.025stake
+  api:
+    enable: true
+    max-open-connections: 1000
+config:
+  rpc:
+    cors_allowed_origins: ["*"]
+    timeout_broadcast_tx_commit: 10s
+`), &overlay))
+	require.Equal(t, Overlay{
+		FileApp: {
+			"":    {"minimum-gas-prices": `"0.025stake"`},
+			"api": {"enable": "true", "max-open-connections": "1000"},
+		},
+		FileConfig: {"rpc": {"cors_allowed_origins": `["*"]`, "timeout_broadcast_tx_commit": `"10s"`}},
+	}, overlay)
+
+	require.Error(t, yaml.Unmarshal([]byte("genesis:\n  chain_id: test\n"), &overlay))
+}
+
+func TestMerge(t *testing.T) {
+	overlay := Merge(
+		Overlay{FileApp: {"api": {"enable": "false", "swagger": "true"}}},
+		Overlay{FileApp: {"api": {"enable": "true"}}},
+	)
+	require.Equal(t, Overlay{FileApp: {"api": {"enable": "true", "swagger": "true"}}}, overlay)
+}
+
+func TestApply(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, os.WriteFile(FileConfig.Path(home), []byte(`moniker = "node"
+
+[p2p]
+laddr = "tcp://0.0.0.0:26666"
+external_address = ""
+`), 0o644))
+
+	addr, err := ExternalAddress(home, "10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1:26666", addr)
+
+	overlay := Overlay{FileConfig: {"p2p": {"external_address": `"10.0.0.1:26666"`}}}
+	changes, err := overlay.Apply(home)
+	require.NoError(t, err)
+	require.Equal(t, []Change{{
+		File: FileConfig,
+		Diff: []DiffLine{
+			{Op: DiffRemoved, Section: "p2p", Text: `external_address = ""`},
+			{Op: DiffAdded, Section: "p2p", Text: `external_address = "10.0.0.1:26666"`},
+		},
+	}}, changes)
+
+	// the config is already patched.
+	changes, err = overlay.Apply(home)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	_, err = Overlay{FileApp: {"api": {"enable": "true"}}}.Apply(home)
+	require.Error(t, err)
+}
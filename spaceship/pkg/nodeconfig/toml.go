@@ -0,0 +1,167 @@
+package nodeconfig
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// SetTOMLValues sets the values of the keys from a TOML section, keeping the other
+// lines and comments. The keys not found are added to the section. The empty section
+// is the root table, before the first section header. Multi-line values, such as
+// arrays, are replaced with all their lines.
+func SetTOMLValues(data []byte, section string, values map[string]string) ([]byte, error) {
+	var (
+		lines     = strings.Split(string(data), "\n")
+		result    = make([]string, 0, len(lines)+len(values))
+		header    = fmt.Sprintf("[%s]", section)
+		inSection = section == ""
+		found     = section == ""
+		set       = make(map[string]bool)
+		// depth is the number of brackets left open by the previous value, its
+		// next lines are continuation lines, skipped if the value is replaced.
+		depth    int
+		replaced bool
+	)
+	// addMissing adds the keys not found to the end of the section, before its trailing blank lines.
+	addMissing := func() {
+		end := len(result)
+		for end > 0 && strings.TrimSpace(result[end-1]) == "" {
+			end--
+		}
+		blank := slices.Clone(result[end:])
+		result = result[:end]
+		for _, key := range sortedKeys(values) {
+			if !set[key] {
+				result = append(result, fmt.Sprintf("%s = %s", key, values[key]))
+				set[key] = true
+			}
+		}
+		result = append(result, blank...)
+	}
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if depth > 0 {
+			depth += valueDepth(trimmed)
+			if !replaced {
+				result = append(result, line)
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			if inSection {
+				addMissing()
+			}
+			inSection = trimmed == header
+			found = found || inSection
+			result = append(result, line)
+			continue
+		}
+		key, value, ok := strings.Cut(trimmed, "=")
+		if ok && !strings.HasPrefix(trimmed, "#") {
+			key = strings.TrimSpace(key)
+			depth = valueDepth(value)
+			newValue, exist := values[key]
+			replaced = inSection && exist
+			if replaced {
+				result = append(result, fmt.Sprintf("%s = %s", key, newValue))
+				set[key] = true
+				continue
+			}
+		}
+		result = append(result, line)
+	}
+	if !found {
+		return nil, errors.Errorf("section %s not found", section)
+	}
+	if inSection {
+		addMissing()
+	}
+	return []byte(strings.Join(result, "\n")), nil
+}
+
+// sortedKeys returns the map keys sorted.
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// TOMLValue returns the raw value of a key from a TOML section, or false if the key is not found.
+// The lines of multi-line values are joined with newlines.
+func TOMLValue(data []byte, section, key string) (string, bool) {
+	var (
+		inSection = section == ""
+		depth     int
+		value     []string
+	)
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if depth > 0 {
+			depth += valueDepth(trimmed)
+			if value != nil {
+				value = append(value, trimmed)
+			}
+			continue
+		}
+		if value != nil {
+			break
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			inSection = trimmed == fmt.Sprintf("[%s]", section)
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		k, v, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			continue
+		}
+		depth = valueDepth(v)
+		if inSection && strings.TrimSpace(k) == key {
+			value = []string{strings.TrimSpace(v)}
+		}
+	}
+	if value == nil {
+		return "", false
+	}
+	return strings.Join(value, "\n"), true
+}
+
+// valueDepth returns the number of arrays and inline tables opened and not closed by
+// the TOML value, ignoring the brackets within strings and comments.
+func valueDepth(value string) int {
+	var (
+		depth   int
+		quote   rune
+		escaped bool
+	)
+	for _, r := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' && quote == '"' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#':
+			return depth
+		case r == '[' || r == '{':
+			depth++
+		case r == ']' || r == '}':
+			depth--
+		}
+	}
+	return depth
+}
@@ -0,0 +1,128 @@
+package nodeconfig
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTOMLValues(t *testing.T) {
+	config := `# comment
+moniker = "node"
+
+[p2p]
+# persistent peers
+persistent_peers = ""
+pex = true
+
+[mempool]
+size = 5000
+`
+	got, err := SetTOMLValues([]byte(config), "p2p", map[string]string{
+		"persistent_peers": `"id1@10.0.0.1:26656"`,
+		"pex":              "false",
+		"addr_book_strict": "false",
+	})
+	require.NoError(t, err)
+	require.Equal(t, `# comment
+moniker = "node"
+
+[p2p]
+# persistent peers
+persistent_peers = "id1@10.0.0.1:26656"
+pex = false
+addr_book_strict = false
+
+[mempool]
+size = 5000
+`, string(got))
+
+	_, err = SetTOMLValues([]byte(config), "rpc", nil)
+	require.EqualError(t, err, "section rpc not found")
+}
+
+func TestSetTOMLRootValues(t *testing.T) {
+	config := `# comment
+minimum-gas-prices = ""
+
+[api]
+enable = false
+`
+	got, err := SetTOMLValues([]byte(config), "", map[string]string{
+		"minimum-gas-prices": `"0.025stake"`,
+		"pruning":            `"nothing"`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `# comment
+minimum-gas-prices = "0.025stake"
+pruning = "nothing"
+
+[api]
+enable = false
+`, string(got))
+}
+
+func TestSetTOMLMultiLineValues(t *testing.T) {
+	// testdata/app.toml is the default app.toml of the Cosmos SDK
+	config, err := os.ReadFile("testdata/app.toml")
+	require.NoError(t, err)
+
+	got, err := SetTOMLValues(config, "telemetry", map[string]string{
+		"enabled":       "true",
+		"global-labels": `[["chain_id", "hub-1"]]`,
+	})
+	require.NoError(t, err)
+	want := strings.Replace(string(config), "enabled = false", "enabled = true", 1)
+	want = strings.Replace(want, "global-labels = [\n]", `global-labels = [["chain_id", "hub-1"]]`, 1)
+	require.Equal(t, want, string(got))
+
+	// the array elements are not section headers
+	config = []byte(`[telemetry]
+global-labels = [
+  ["chain_id", "hub-1"], # chain
+  ["env", "test]"],
+]
+enabled = true
+
+[api]
+enable = false
+`)
+	value, ok := TOMLValue(config, "telemetry", "global-labels")
+	require.True(t, ok)
+	require.Equal(t, "[\n[\"chain_id\", \"hub-1\"], # chain\n[\"env\", \"test]\"],\n]", value)
+
+	got, err = SetTOMLValues(config, "telemetry", map[string]string{
+		"global-labels": "[]",
+		"service-name":  `"hub"`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `[telemetry]
+global-labels = []
+enabled = true
+service-name = "hub"
+
+[api]
+enable = false
+`, string(got))
+}
+
+func TestTOMLValue(t *testing.T) {
+	config := []byte(`moniker = "node"
+
+[p2p]
+# laddr = "tcp://127.0.0.1:1"
+laddr = "tcp://0.0.0.0:26656"
+`)
+	value, ok := TOMLValue(config, "p2p", "laddr")
+	require.True(t, ok)
+	require.Equal(t, `"tcp://0.0.0.0:26656"`, value)
+
+	value, ok = TOMLValue(config, "", "moniker")
+	require.True(t, ok)
+	require.Equal(t, `"node"`, value)
+
+	_, ok = TOMLValue(config, "rpc", "laddr")
+	require.False(t, ok)
+}
@@ -30,23 +30,35 @@ func (s *SSH) HasRunnerScript(ctx context.Context) bool {
 	return s.FileExist(ctx, s.runnerScript())
 }
 
-// Start runs the "start" script on the remote server.
+// Start runs the "start" script on the remote server, or uses systemctl if the chain is managed by systemd.
 func (s *SSH) Start(ctx context.Context) (string, error) {
+	if s.HasSystemd(ctx) {
+		return s.startUnit(ctx, s.ChainUnit())
+	}
 	return s.runScript(ctx, "start")
 }
 
-// Restart runs the "restart" script on the remote server.
+// Restart runs the "restart" script on the remote server, or uses systemctl if the chain is managed by systemd.
 func (s *SSH) Restart(ctx context.Context) (string, error) {
+	if s.HasSystemd(ctx) {
+		return s.restartUnit(ctx, s.ChainUnit())
+	}
 	return s.runScript(ctx, "restart")
 }
 
-// Stop runs the "stop" script on the remote server.
+// Stop runs the "stop" script on the remote server, or uses systemctl if the chain is managed by systemd.
 func (s *SSH) Stop(ctx context.Context) (string, error) {
+	if s.HasSystemd(ctx) {
+		return s.stopUnit(ctx, s.ChainUnit())
+	}
 	return s.runScript(ctx, "stop")
 }
 
-// Status runs the "status" script on the remote server.
+// Status runs the "status" script on the remote server, or uses systemctl if the chain is managed by systemd.
 func (s *SSH) Status(ctx context.Context) (string, error) {
+	if s.HasSystemd(ctx) {
+		return s.unitStatus(ctx, s.ChainUnit())
+	}
 	return s.runScript(ctx, "status")
 }
 
@@ -54,3 +66,9 @@ func (s *SSH) Status(ctx context.Context) (string, error) {
 func (s *SSH) runScript(ctx context.Context, args ...string) (string, error) {
 	return s.RunCommand(ctx, s.runnerScript(), args...)
 }
+
+// RemoveHome removes the home directory from the remote server.
+func (s *SSH) RemoveHome(ctx context.Context) error {
+	_, err := s.RunCommand(ctx, "rm", "-rf", s.Home())
+	return err
+}
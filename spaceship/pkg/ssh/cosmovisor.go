@@ -0,0 +1,141 @@
+package ssh
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+
+	"github.com/ignite/apps/spaceship/pkg/cosmovisor"
+)
+
+const (
+	cosmovisorDir     = "cosmovisor"
+	cosmovisorGenesis = "genesis"
+)
+
+// UpgradeVersion represents a chain binary version managed by cosmovisor.
+type UpgradeVersion struct {
+	// Name is the upgrade name, or "genesis" for the binary the chain started with.
+	Name string
+	// Time is the time the binary was uploaded.
+	Time time.Time
+	// Current is true if the binary is the one currently run by cosmovisor.
+	Current bool
+}
+
+// Cosmovisor returns the cosmovisor directory within the home directory.
+func (s *SSH) Cosmovisor() string {
+	return filepath.Join(s.Home(), cosmovisorDir)
+}
+
+// HasCosmovisor checks if the chain is run by cosmovisor on the remote server.
+func (s *SSH) HasCosmovisor(ctx context.Context) bool {
+	return s.FolderExist(ctx, filepath.Join(s.Cosmovisor(), cosmovisorGenesis))
+}
+
+// UploadCosmovisor uploads the cosmovisor binary to the remote server.
+func (s *SSH) UploadCosmovisor(ctx context.Context, target string, progressCallback ProgressCallback) (string, error) {
+	bin, err := cosmovisor.FetchBinary(ctx, target)
+	if err != nil {
+		return "", err
+	}
+	return s.UploadBinary(ctx, bin, progressCallback)
+}
+
+// UploadGenesisBinary uploads the chain binary the chain starts with into the cosmovisor genesis folder.
+func (s *SSH) UploadGenesisBinary(ctx context.Context, srcPath string, progressCallback ProgressCallback) (string, error) {
+	return s.uploadCosmovisorBinary(ctx, filepath.Join(s.Cosmovisor(), cosmovisorGenesis), srcPath, progressCallback)
+}
+
+// UploadUpgradeBinary uploads the chain binary of an upgrade into the cosmovisor upgrades folder.
+func (s *SSH) UploadUpgradeBinary(ctx context.Context, name, srcPath string, progressCallback ProgressCallback) (string, error) {
+	return s.uploadCosmovisorBinary(ctx, filepath.Join(s.Cosmovisor(), "upgrades", name), srcPath, progressCallback)
+}
+
+// uploadCosmovisorBinary uploads a binary into the bin folder of a cosmovisor version folder.
+func (s *SSH) uploadCosmovisorBinary(ctx context.Context, dir, srcPath string, progressCallback ProgressCallback) (string, error) {
+	binPath := filepath.Join(dir, "bin", filepath.Base(srcPath))
+	if _, err := s.UploadFile(ctx, srcPath, binPath, progressCallback); err != nil {
+		return "", err
+	}
+
+	// give binary permission
+	if err := s.sftpClient.Chmod(binPath, 0o755); err != nil {
+		return "", err
+	}
+	return binPath, nil
+}
+
+// CurrentBinary returns the path of the chain binary currently run by cosmovisor.
+func (s *SSH) CurrentBinary(binary string) string {
+	return filepath.Join(s.Cosmovisor(), "current", "bin", binary)
+}
+
+// UpgradeVersions returns the chain binary versions managed by cosmovisor, sorted by upload time.
+func (s *SSH) UpgradeVersions() ([]UpgradeVersion, error) {
+	// the current link is only created by cosmovisor once the chain started.
+	current := cosmovisorGenesis
+	if link, err := s.sftpClient.ReadLink(filepath.Join(s.Cosmovisor(), "current")); err == nil {
+		current = filepath.Base(link)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrap(err, "failed to read the cosmovisor current version")
+	}
+
+	genesis, err := s.sftpClient.Stat(filepath.Join(s.Cosmovisor(), cosmovisorGenesis))
+	if err != nil {
+		return nil, err
+	}
+	versions := []UpgradeVersion{{
+		Name:    cosmovisorGenesis,
+		Time:    genesis.ModTime(),
+		Current: current == cosmovisorGenesis,
+	}}
+
+	upgrades, err := s.sftpClient.ReadDir(filepath.Join(s.Cosmovisor(), "upgrades"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for _, upgrade := range upgrades {
+		if !upgrade.IsDir() {
+			continue
+		}
+		versions = append(versions, UpgradeVersion{
+			Name:    upgrade.Name(),
+			Time:    upgrade.ModTime(),
+			Current: current == upgrade.Name(),
+		})
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Time.Before(versions[j].Time)
+	})
+	return versions, nil
+}
+
+// BlockHeight returns the latest block height of the node running on the remote server.
+func (s *SSH) BlockHeight(ctx context.Context, binary string) (int64, error) {
+	output, err := s.RunCommand(ctx, s.CurrentBinary(binary), "status", "--home", s.Home())
+	if err != nil {
+		return 0, err
+	}
+	var status struct {
+		SyncInfo struct {
+			LatestBlockHeight string `json:"latest_block_height"`
+		} `json:"sync_info"`
+	}
+	if err := json.Unmarshal([]byte(output), &status); err != nil {
+		return 0, errors.Wrapf(err, "failed to decode the node status: %s", output)
+	}
+	return strconv.ParseInt(status.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// RemoveCosmovisor removes the cosmovisor binaries and upgrades from the home directory.
+func (s *SSH) RemoveCosmovisor(ctx context.Context) error {
+	_, err := s.RunCommand(ctx, "rm", "-rf", s.Cosmovisor())
+	return err
+}
@@ -16,23 +16,45 @@ func (s *SSH) HasFaucetScript(ctx context.Context) bool {
 	return s.FileExist(ctx, s.faucetScript())
 }
 
-// FaucetStart runs the faucet "start" script on the remote server.
+// FaucetStart runs the faucet "start" script on the remote server, or uses systemctl if the
+// chain is managed by systemd.
 func (s *SSH) FaucetStart(ctx context.Context, port uint64) (string, error) {
+	if s.HasSystemd(ctx) {
+		if err := s.writeFaucetEnv(port); err != nil {
+			return "", err
+		}
+		return s.startUnit(ctx, s.FaucetUnit())
+	}
 	return s.runFaucetScript(ctx, "start", strconv.FormatUint(port, 10))
 }
 
-// FaucetRestart runs the faucet "restart" script on the remote server.
+// FaucetRestart runs the faucet "restart" script on the remote server, or uses systemctl if the
+// chain is managed by systemd.
 func (s *SSH) FaucetRestart(ctx context.Context, port uint64) (string, error) {
+	if s.HasSystemd(ctx) {
+		if err := s.writeFaucetEnv(port); err != nil {
+			return "", err
+		}
+		return s.restartUnit(ctx, s.FaucetUnit())
+	}
 	return s.runFaucetScript(ctx, "restart", strconv.FormatUint(port, 10))
 }
 
-// FaucetStop runs the faucet "stop" script on the remote server.
+// FaucetStop runs the faucet "stop" script on the remote server, or uses systemctl if the
+// chain is managed by systemd.
 func (s *SSH) FaucetStop(ctx context.Context) (string, error) {
+	if s.HasSystemd(ctx) {
+		return s.stopUnit(ctx, s.FaucetUnit())
+	}
 	return s.runFaucetScript(ctx, "stop")
 }
 
-// FaucetStatus runs the faucet "status" script on the remote server.
+// FaucetStatus runs the faucet "status" script on the remote server, or uses systemctl if the
+// chain is managed by systemd.
 func (s *SSH) FaucetStatus(ctx context.Context) (string, error) {
+	if s.HasSystemd(ctx) {
+		return s.unitStatus(ctx, s.FaucetUnit())
+	}
 	return s.runFaucetScript(ctx, "status")
 }
 
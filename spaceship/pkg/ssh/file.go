@@ -3,6 +3,11 @@ package ssh
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
 
 // FolderExist checks if a directory exists at the specified path on the remote server.
@@ -31,3 +36,26 @@ func (s *SSH) exist(ctx context.Context, path string, isFile bool) bool {
 	}
 	return exist == "true"
 }
+
+// DownloadFile downloads a file from the remote server to the local destination path.
+func (s *SSH) DownloadFile(srcPath, dstPath string) error {
+	srcFile, err := s.sftpClient.Open(srcPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open remote file %s", srcPath)
+	}
+	defer srcFile.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dstPath), 0o755); err != nil {
+		return err
+	}
+	dstFile, err := os.Create(dstPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create file %s", dstPath)
+	}
+	defer dstFile.Close()
+
+	if _, err := io.Copy(dstFile, srcFile); err != nil {
+		return errors.Wrapf(err, "failed to download file %s to %s", srcPath, dstPath)
+	}
+	return dstFile.Close()
+}
@@ -0,0 +1,253 @@
+package ssh
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// txPollInterval is the interval to check if a broadcast transaction was included in a block.
+	txPollInterval = 2 * time.Second
+	// txTimeout is the max time to wait for a broadcast transaction to be included in a block.
+	txTimeout = time.Minute
+)
+
+// txResponse represents the fields used from a chain binary tx JSON output.
+type txResponse struct {
+	Code   uint32 `json:"code"`
+	TxHash string `json:"txhash"`
+	RawLog string `json:"raw_log"`
+	Events []struct {
+		Type       string `json:"type"`
+		Attributes []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"attributes"`
+	} `json:"events"`
+}
+
+// attribute returns the value of the first event attribute with the event type and key.
+func (r txResponse) attribute(eventType, key string) (string, bool) {
+	for _, event := range r.Events {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == key {
+				return attribute.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// MinDeposit returns the minimum deposit of a governance proposal.
+func (s *SSH) MinDeposit(ctx context.Context, binary string) (string, error) {
+	output, err := s.chainCommand(ctx, binary, "query", "gov", "params", "--output", "json")
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Params struct {
+			MinDeposit []struct {
+				Denom  string `json:"denom"`
+				Amount string `json:"amount"`
+			} `json:"min_deposit"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		return "", errors.Wrapf(err, "failed to decode the gov params: %s", output)
+	}
+
+	deposit := make([]string, 0, len(resp.Params.MinDeposit))
+	for _, coin := range resp.Params.MinDeposit {
+		deposit = append(deposit, coin.Amount+coin.Denom)
+	}
+	return strings.Join(deposit, ","), nil
+}
+
+// SubmitUpgradeProposal submits a software upgrade governance proposal from the key for the
+// upgrade name and height, and returns the proposal id once it is included in a block. It fails
+// if the transaction fails or isn't included in a block before the tx timeout.
+func (s *SSH) SubmitUpgradeProposal(
+	ctx context.Context,
+	binary,
+	chainID,
+	from,
+	name string,
+	height int64,
+	deposit string,
+) (uint64, error) {
+	lastID, err := s.lastProposalID(ctx, binary)
+	if err != nil {
+		return 0, err
+	}
+
+	txHash, err := s.chainTx(
+		ctx,
+		binary,
+		chainID,
+		from,
+		"upgrade",
+		"software-upgrade",
+		name,
+		"--upgrade-height", strconv.FormatInt(height, 10),
+		"--title", name,
+		"--summary", name,
+		"--deposit", deposit,
+		"--no-validate",
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to submit the upgrade proposal")
+	}
+	resp, err := s.waitTx(ctx, binary, txHash)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to submit the upgrade proposal")
+	}
+	if id, ok := resp.attribute("submit_proposal", "proposal_id"); ok {
+		return strconv.ParseUint(id, 10, 64)
+	}
+
+	// the chain doesn't emit the proposal id, look for the new proposal.
+	id, err := s.lastProposalID(ctx, binary)
+	if err != nil {
+		return 0, err
+	}
+	if id <= lastID {
+		return 0, errors.Errorf("upgrade proposal of tx %s not found", txHash)
+	}
+	return id, nil
+}
+
+// VoteProposal votes yes on the governance proposal from the key.
+func (s *SSH) VoteProposal(ctx context.Context, binary, chainID, from string, id uint64) error {
+	_, err := s.chainTx(ctx, binary, chainID, from, "gov", "vote", strconv.FormatUint(id, 10), "yes")
+	return err
+}
+
+// UpgradePlan returns the name and height of the scheduled upgrade, or zero if there is none.
+func (s *SSH) UpgradePlan(ctx context.Context, binary string) (string, int64, error) {
+	output, err := s.chainCommand(ctx, binary, "query", "upgrade", "plan", "--output", "json")
+	if err != nil {
+		if strings.Contains(err.Error(), "no upgrade scheduled") {
+			return "", 0, nil
+		}
+		return "", 0, err
+	}
+	var resp struct {
+		Plan struct {
+			Name   string `json:"name"`
+			Height string `json:"height"`
+		} `json:"plan"`
+	}
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		return "", 0, errors.Wrapf(err, "failed to decode the upgrade plan: %s", output)
+	}
+	if resp.Plan.Height == "" {
+		return "", 0, nil
+	}
+	height, err := strconv.ParseInt(resp.Plan.Height, 10, 64)
+	return resp.Plan.Name, height, err
+}
+
+// lastProposalID returns the id of the last governance proposal, or zero if there is none.
+func (s *SSH) lastProposalID(ctx context.Context, binary string) (uint64, error) {
+	output, err := s.chainCommand(ctx, binary, "query", "gov", "proposals", "--output", "json")
+	if err != nil {
+		if strings.Contains(err.Error(), "no proposals found") {
+			return 0, nil
+		}
+		return 0, err
+	}
+	var resp struct {
+		Proposals []struct {
+			ID string `json:"id"`
+		} `json:"proposals"`
+	}
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		return 0, errors.Wrapf(err, "failed to decode the gov proposals: %s", output)
+	}
+
+	var lastID uint64
+	for _, proposal := range resp.Proposals {
+		id, err := strconv.ParseUint(proposal.ID, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid proposal id %s", proposal.ID)
+		}
+		lastID = max(lastID, id)
+	}
+	return lastID, nil
+}
+
+// chainTx broadcasts a transaction from the key of the remote chain keyring and returns its hash.
+func (s *SSH) chainTx(ctx context.Context, binary, chainID, from string, args ...string) (string, error) {
+	args = append([]string{"tx"}, args...)
+	args = append(args,
+		"--from", from,
+		"--chain-id", chainID,
+		"--keyring-backend", "test",
+		"--gas", "auto",
+		"--gas-adjustment", "1.5",
+		"--output", "json",
+		"--yes",
+	)
+	output, err := s.chainCommand(ctx, binary, args...)
+	if err != nil {
+		return "", err
+	}
+
+	// the gas estimate is written before the tx response.
+	lines := strings.Split(output, "\n")
+	var resp txResponse
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &resp); err != nil {
+		return "", errors.Wrapf(err, "failed to decode the tx response: %s", output)
+	}
+	if resp.Code != 0 {
+		return "", errors.Errorf("tx %s failed with code %d: %s", resp.TxHash, resp.Code, resp.RawLog)
+	}
+	return resp.TxHash, nil
+}
+
+// waitTx waits for the transaction to be included in a block and returns its result.
+// It fails if the transaction failed or isn't included before the tx timeout.
+func (s *SSH) waitTx(ctx context.Context, binary, txHash string) (txResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, txTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return txResponse{}, errors.Wrapf(ctx.Err(), "tx %s not included in a block", txHash)
+		case <-ticker.C:
+		}
+
+		output, err := s.chainCommand(ctx, binary, "query", "tx", txHash, "--output", "json")
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			return txResponse{}, err
+		}
+		var resp txResponse
+		if err := json.Unmarshal([]byte(output), &resp); err != nil {
+			return txResponse{}, errors.Wrapf(err, "failed to decode the tx %s result: %s", txHash, output)
+		}
+		if resp.Code != 0 {
+			return txResponse{}, errors.Errorf("tx %s failed with code %d: %s", txHash, resp.Code, resp.RawLog)
+		}
+		return resp, nil
+	}
+}
+
+// chainCommand runs the chain binary currently run by cosmovisor with the chain home.
+func (s *SSH) chainCommand(ctx context.Context, binary string, args ...string) (string, error) {
+	args = append(args, "--home", s.Home())
+	return s.RunCommand(ctx, s.CurrentBinary(binary), args...)
+}
@@ -0,0 +1,29 @@
+package ssh
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxResponseAttribute(t *testing.T) {
+	output := `{
+  "height": "120",
+  "txhash": "2A5F",
+  "code": 0,
+  "events": [
+    {"type": "message", "attributes": [{"key": "action", "value": "/cosmos.gov.v1.MsgSubmitProposal"}]},
+    {"type": "submit_proposal", "attributes": [{"key": "proposal_id", "value": "7"}, {"key": "proposal_messages", "value": ",/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"}]}
+  ]
+}`
+	var resp txResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &resp))
+
+	id, ok := resp.attribute("submit_proposal", "proposal_id")
+	require.True(t, ok)
+	require.Equal(t, "7", id)
+
+	_, ok = resp.attribute("proposal_deposit", "proposal_id")
+	require.False(t, ok)
+}
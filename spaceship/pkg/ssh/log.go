@@ -2,11 +2,14 @@ package ssh
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,10 +20,11 @@ type (
 	// LogType represents the log type.
 	LogType string
 
-	// log represents a log file with its name and modification time.
+	// log represents a log file with its name, creation and modification times.
 	log struct {
-		name string
-		time time.Time
+		name  string
+		start time.Time
+		time  time.Time
 	}
 
 	// logs implements sort.Interface based on the Time field.
@@ -30,6 +34,13 @@ type (
 const (
 	logExtension = ".log"
 
+	// tailBlockSize is the size of the blocks read backward from the end of a log file.
+	tailBlockSize = 32 * 1024
+	// maxLogLineSize is the maximum size of a log line searched.
+	maxLogLineSize = 1024 * 1024
+	// followPollInterval is the interval to check for new log lines.
+	followPollInterval = time.Second
+
 	LogChain  LogType = "chain"
 	LogFaucet LogType = "faucet"
 )
@@ -63,62 +74,157 @@ func (s *SSH) Log() string {
 	return filepath.Join(s.Workspace(), "log")
 }
 
-// LatestLog returns the last n lines from the latest log file.
-func (s *SSH) LatestLog(logType LogType, n int) (string, error) {
-	logFiles, err := s.getLogFiles(logType)
+// logUnit returns the systemd unit of the log type.
+func (s *SSH) logUnit(logType LogType) string {
+	if logType == LogFaucet {
+		return s.FaucetUnit()
+	}
+	return s.ChainUnit()
+}
+
+// LatestLog returns the last n lines from the log files, or from the journal if the chain
+// is managed by systemd. The lines can be filtered by time and regular expression, and
+// all the matching lines are returned if n is zero.
+func (s *SSH) LatestLog(ctx context.Context, logType LogType, n int, options ...LogOption) (string, error) {
+	query := newLogQuery(options...)
+	if s.HasSystemd(ctx) {
+		args := journalArgs(s.logUnit(logType), query)
+		if n > 0 {
+			args = append(args, "--lines", strconv.Itoa(n))
+		}
+		return s.RunCommand(ctx, journalctlUser, args...)
+	}
+
+	logFiles, err := s.getLogFiles(logType, s.remoteLocation(ctx))
 	if err != nil {
 		return "", errors.Wrap(err, "error fetching log files")
 	}
 	if len(logFiles) == 0 {
-		return "", errors.Wrap(err, "no log files found")
+		return "", errors.New("no log files found")
 	}
 	// Sort log files by modification time.
 	sort.Sort(logFiles)
 
-	// Get the latest log file
-	latestLogFile := logFiles[len(logFiles)-1]
-	lines, err := s.readLastNLines(latestLogFile.name, n)
-	if err != nil {
-		return "", errors.Wrap(err, "error reading last log line")
+	// Read the log files from the latest one until there are enough lines.
+	lines := make([]string, 0)
+	for i := len(logFiles) - 1; i >= 0 && (n <= 0 || len(lines) < n); i-- {
+		logFile := logFiles[i]
+		if !query.matchFile(logFile.start, logFile.time) {
+			continue
+		}
+
+		remaining := 0
+		if n > 0 {
+			remaining = n - len(lines)
+		}
+		var fileLines []string
+		if query.filtered() {
+			fileLines, err = s.searchLines(logFile, remaining, query)
+		} else {
+			fileLines, err = s.tailLines(logFile.name, remaining)
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "error reading log file %s", logFile.name)
+		}
+		lines = append(fileLines, lines...)
 	}
 	return strings.Join(lines, "\n"), nil
 }
 
-// readLastNLines reads the last n lines from the specified file.
-func (s *SSH) readLastNLines(filePath string, n int) ([]string, error) {
+// tailLines reads the last n lines from the specified file, or all the lines if n is zero.
+// The file is read backward by blocks, so only the end of large files is transferred.
+func (s *SSH) tailLines(filePath string, n int) ([]string, error) {
 	file, err := s.sftpClient.OpenFile(filePath, os.O_RDONLY)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	// Use a buffer to read the file
-	lines := make([]string, 0)
-	scanner := bufio.NewScanner(file)
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return lastLines(file, info.Size(), n)
+}
 
-	// Read lines into a buffer
-	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
-		if len(lines) == n {
-			break
+// lastLines returns the last n lines of the reader of the size, or all the lines if n is zero.
+func lastLines(r io.ReaderAt, size int64, n int) ([]string, error) {
+	// Read blocks until the data has more than n line breaks, so the first line is complete.
+	var (
+		offset = size
+		data   []byte
+	)
+	for offset > 0 && (n <= 0 || bytes.Count(data, []byte{'\n'}) <= n) {
+		blockSize := min(tailBlockSize, offset)
+		offset -= blockSize
+		block := make([]byte, blockSize)
+		if _, err := r.ReadAt(block, offset); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
 		}
+		data = append(block, data...)
+	}
+
+	data = bytes.TrimRight(data, "\n")
+	if len(data) == 0 {
+		return nil, nil
+	}
+	lines := strings.Split(string(data), "\n")
+	if n > 0 && len(lines) > n {
+		lines = lines[len(lines)-n:]
 	}
-	if err := scanner.Err(); err != nil {
+	return lines, nil
+}
+
+// searchLines reads the last n lines of the log file matching the query, or all the
+// matching lines if n is zero.
+func (s *SSH) searchLines(logFile log, n int, query logQuery) ([]string, error) {
+	file, err := s.sftpClient.OpenFile(logFile.name, os.O_RDONLY)
+	if err != nil {
 		return nil, err
 	}
+	defer file.Close()
 
-	return lines, nil
+	var (
+		lines   = make([]string, 0)
+		clock   = logClock{current: logFile.start}
+		scanner = bufio.NewScanner(file)
+	)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		t := clock.update(line)
+		// the lines are written in order, so the next ones are also too recent.
+		if !query.until.IsZero() && t.After(query.until) {
+			break
+		}
+		if !query.matchTime(t) || !query.matchText(line) {
+			continue
+		}
+		lines = append(lines, line)
+		if n > 0 && len(lines) > n {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
 }
 
-// FollowLog follows the latest log file and sends new lines to the provided channel in real-time.
-func (s *SSH) FollowLog(ctx context.Context, logType LogType, ch chan<- string) error {
-	logFiles, err := s.getLogFiles(logType)
+// FollowLog follows the latest log file, or the journal if the chain is managed by systemd,
+// and sends new lines matching the regular expression option to the provided channel in
+// real-time. When the runner script creates a new log file, the new file is followed.
+func (s *SSH) FollowLog(ctx context.Context, logType LogType, ch chan<- string, options ...LogOption) error {
+	query := newLogQuery(options...)
+	if s.HasSystemd(ctx) {
+		return s.followJournal(ctx, logType, query, ch)
+	}
+
+	loc := s.remoteLocation(ctx)
+	logFiles, err := s.getLogFiles(logType, loc)
 	if err != nil {
 		return errors.Wrap(err, "error fetching log files")
 	}
 
 	if len(logFiles) == 0 {
-		return errors.Wrap(err, "no log files found")
+		return errors.New("no log files found")
 	}
 	// Sort log files by modification time.
 	sort.Sort(logFiles)
@@ -129,32 +235,142 @@ func (s *SSH) FollowLog(ctx context.Context, logType LogType, ch chan<- string)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
+	defer func() {
+		_ = file.Close()
+	}()
 
 	// Seek to the end of the file
-	file.Seek(0, io.SeekEnd)
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+
+	send := func(line string) error {
+		if !query.matchText(line) {
+			return nil
+		}
+		select {
+		case ch <- line:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	ticker := time.NewTicker(followPollInterval)
+	defer ticker.Stop()
 
 	reader := bufio.NewReader(file)
 	for {
+		line, err := reader.ReadString('\n')
+		if err == nil {
+			if err := send(line); err != nil {
+				return err
+			}
+			continue
+		}
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		default:
-			line, err := reader.ReadString('\n')
-			if err != nil {
-				if err == io.EOF {
-					time.Sleep(1 * time.Second)
-					continue
-				}
+		case <-ticker.C:
+		}
+
+		nextLogFile, err := s.nextLogFile(logType, loc, latestLogFile)
+		if err != nil {
+			return err
+		}
+		if nextLogFile.name == "" {
+			// keep the partial line until the end of the line is written.
+			reader = bufio.NewReader(io.MultiReader(strings.NewReader(line), file))
+			continue
+		}
+
+		// Send the end of the current file before following the new one.
+		rest, err := io.ReadAll(io.MultiReader(strings.NewReader(line), file))
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.SplitAfter(string(rest), "\n") {
+			if line == "" {
+				continue
+			}
+			if err := send(line); err != nil {
 				return err
 			}
-			ch <- line
 		}
+
+		_ = file.Close()
+		if file, err = s.sftpClient.OpenFile(nextLogFile.name, os.O_RDONLY); err != nil {
+			return err
+		}
+		latestLogFile = nextLogFile
+		reader = bufio.NewReader(file)
+	}
+}
+
+// nextLogFile returns the latest log file if it is newer than the current one, or an empty log otherwise.
+func (s *SSH) nextLogFile(logType LogType, loc *time.Location, current log) (log, error) {
+	logFiles, err := s.getLogFiles(logType, loc)
+	if err != nil {
+		return log{}, errors.Wrap(err, "error fetching log files")
+	}
+	sort.Sort(logFiles)
+	if len(logFiles) == 0 {
+		return log{}, nil
+	}
+	latestLogFile := logFiles[len(logFiles)-1]
+	if latestLogFile.name == current.name || latestLogFile.start.Before(current.start) {
+		return log{}, nil
 	}
+	return latestLogFile, nil
 }
 
-// getLogFiles fetches all log files from the specified directory.
-func (s *SSH) getLogFiles(logType LogType) (logs, error) {
+// followJournal follows the systemd unit journal and sends new lines to the provided channel in real-time.
+func (s *SSH) followJournal(ctx context.Context, logType LogType, query logQuery, ch chan<- string) error {
+	args := append(journalArgs(s.logUnit(logType), logQuery{grep: query.grep}), "--lines", "0", "--follow")
+	cmd, err := s.client.CommandContext(ctx, journalctlUser, args...)
+	if err != nil {
+		return err
+	}
+	defer cmd.Close()
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	// Close the session to stop reading once the context is canceled.
+	go func() {
+		<-ctx.Done()
+		_ = cmd.Close()
+	}()
+
+	reader := bufio.NewReader(stdout)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		select {
+		case ch <- line:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// getLogFiles fetches all log files from the specified directory. The file creation
+// times are parsed from their names in the loc time zone.
+func (s *SSH) getLogFiles(logType LogType, loc *time.Location) (logs, error) {
 	dir := s.Log()
 
 	files, err := s.sftpClient.ReadDir(dir)
@@ -173,9 +389,72 @@ func (s *SSH) getLogFiles(logType LogType) (logs, error) {
 			continue
 		}
 		logFiles = append(logFiles, log{
-			name: filepath.Join(dir, file.Name()),
-			time: file.ModTime(),
+			name:  filepath.Join(dir, file.Name()),
+			start: logFileStart(file.Name(), logType, loc),
+			time:  file.ModTime(),
 		})
 	}
 	return logFiles, nil
 }
+
+// remoteLocation returns the time zone of the remote server, used by the log file names
+// and the chain console logger times. It falls back to UTC if the zone is unknown.
+func (s *SSH) remoteLocation(ctx context.Context) *time.Location {
+	output, err := s.RunCommand(ctx, "date", "+%z")
+	if err != nil {
+		return time.UTC
+	}
+	loc, err := parseZoneOffset(output)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// journalArgs returns the journalctl arguments to read the unit journal matching the query.
+func journalArgs(unit string, query logQuery) []string {
+	args := []string{"--unit", unit}
+	if !query.since.IsZero() {
+		args = append(args, "--since", shellQuote(query.since.UTC().Format(time.DateTime)+" UTC"))
+	}
+	if !query.until.IsZero() {
+		args = append(args, "--until", shellQuote(query.until.UTC().Format(time.DateTime)+" UTC"))
+	}
+	if query.grep != nil {
+		args = append(args, "--grep", shellQuote(query.grep.String()))
+	}
+	return args
+}
+
+// DownloadLogs writes a gzipped tarball of the log directory to the writer. If the chain is
+// managed by systemd, the chain and faucet journals are added into the journal folder.
+func (s *SSH) DownloadLogs(ctx context.Context, w io.Writer) error {
+	command := fmt.Sprintf("tar -czf - -C %s log", shellQuote(s.Workspace()))
+	if s.HasSystemd(ctx) {
+		journal := strings.Replace(journalctlUser, "--output cat", "--output short-iso", 1)
+		command = fmt.Sprintf(
+			`dir=$(mktemp -d) && mkdir "$dir/journal" && `+
+				`%[1]s --unit %[2]s > "$dir/journal/chain.log"; `+
+				`%[1]s --unit %[3]s > "$dir/journal/faucet.log"; `+
+				`tar -czf - -C %[4]s log -C "$dir" journal; status=$?; rm -rf "$dir"; exit $status`,
+			journal,
+			s.ChainUnit(),
+			s.FaucetUnit(),
+			shellQuote(s.Workspace()),
+		)
+	}
+
+	cmd, err := s.client.CommandContext(ctx, command)
+	if err != nil {
+		return err
+	}
+	defer cmd.Close()
+
+	var stderr bytes.Buffer
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to archive the logs: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
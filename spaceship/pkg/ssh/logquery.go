@@ -0,0 +1,186 @@
+package ssh
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// logFileTimeLayout is the time layout of the log file names created by the runner scripts.
+const logFileTimeLayout = "2006-01-02_15-04-05"
+
+var (
+	// reANSI matches the terminal color codes written by the chain logger.
+	reANSI = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	// reTimestamp matches a date and time, e.g. the time field of the chain JSON logs.
+	reTimestamp = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+	// reKitchen matches the time written by the chain console logger, e.g. 3:04PM.
+	reKitchen = regexp.MustCompile(`(^|[^\d:])((1[0-2]|0?[1-9]):[0-5]\d[AP]M)`)
+
+	// timestampLayouts are the layouts of the timestamps matched by reTimestamp.
+	timestampLayouts = []string{
+		"2006-01-02T15:04:05Z07:00",
+		"2006-01-02T15:04:05Z0700",
+		"2006-01-02T15:04:05",
+	}
+	// logTimeLayouts are the layouts accepted by ParseLogTime.
+	logTimeLayouts = []string{
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		time.DateTime,
+		"2006-01-02 15:04",
+		time.DateOnly,
+	}
+)
+
+type (
+	// LogOption configures the log lines returned by LatestLog and FollowLog.
+	LogOption func(*logQuery)
+
+	// logQuery represents the filters applied to the log lines.
+	logQuery struct {
+		since time.Time
+		until time.Time
+		grep  *regexp.Regexp
+	}
+
+	// logClock tracks the time of the lines of a log file. The lines without a
+	// timestamp, such as multi-line errors, get the time of the previous line.
+	logClock struct {
+		current time.Time
+	}
+)
+
+// WithLogSince returns the log lines written at or after the time.
+func WithLogSince(since time.Time) LogOption {
+	return func(q *logQuery) {
+		q.since = since
+	}
+}
+
+// WithLogUntil returns the log lines written at or before the time.
+func WithLogUntil(until time.Time) LogOption {
+	return func(q *logQuery) {
+		q.until = until
+	}
+}
+
+// WithLogGrep returns the log lines matching the regular expression.
+func WithLogGrep(grep *regexp.Regexp) LogOption {
+	return func(q *logQuery) {
+		q.grep = grep
+	}
+}
+
+// newLogQuery returns the log query configured by the options.
+func newLogQuery(options ...LogOption) logQuery {
+	var q logQuery
+	for _, apply := range options {
+		apply(&q)
+	}
+	return q
+}
+
+// timeFiltered returns true if the query filters the lines by time.
+func (q logQuery) timeFiltered() bool {
+	return !q.since.IsZero() || !q.until.IsZero()
+}
+
+// filtered returns true if the query filters the lines.
+func (q logQuery) filtered() bool {
+	return q.timeFiltered() || q.grep != nil
+}
+
+// matchTime returns true if the time is within the query time range. A zero time
+// is a line with an unknown time, always matched.
+func (q logQuery) matchTime(t time.Time) bool {
+	if t.IsZero() {
+		return true
+	}
+	return (q.since.IsZero() || !t.Before(q.since)) && (q.until.IsZero() || !t.After(q.until))
+}
+
+// matchText returns true if the line matches the query regular expression.
+func (q logQuery) matchText(line string) bool {
+	return q.grep == nil || q.grep.MatchString(reANSI.ReplaceAllString(line, ""))
+}
+
+// matchFile returns true if the log file written between the start and end times
+// can have lines within the query time range.
+func (q logQuery) matchFile(start, end time.Time) bool {
+	if !q.since.IsZero() && end.Before(q.since) {
+		return false
+	}
+	if !q.until.IsZero() && !start.IsZero() && start.After(q.until) {
+		return false
+	}
+	return true
+}
+
+// update parses the time of the log line and returns it.
+func (c *logClock) update(line string) time.Time {
+	line = reANSI.ReplaceAllString(line, "")
+
+	if match := reTimestamp.FindString(line); match != "" {
+		match = strings.Replace(match, " ", "T", 1)
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, match); err == nil {
+				c.current = t
+				return c.current
+			}
+		}
+	}
+
+	// the console logger only writes the time of the day, use the date of the previous line.
+	if match := reKitchen.FindStringSubmatch(line); match != nil && !c.current.IsZero() {
+		kitchen, err := time.Parse(time.Kitchen, match[2])
+		if err != nil {
+			return c.current
+		}
+		year, month, day := c.current.Date()
+		t := time.Date(year, month, day, kitchen.Hour(), kitchen.Minute(), 0, 0, c.current.Location())
+		if t.Before(c.current.Truncate(time.Minute)) {
+			t = t.AddDate(0, 0, 1)
+		}
+		c.current = t
+	}
+	return c.current
+}
+
+// logFileStart returns the creation time of the log file from its name, or a zero time if unknown.
+// The runner scripts name the files with the local time of the remote server, in the loc time zone.
+func logFileStart(name string, logType LogType, loc *time.Location) time.Time {
+	name = strings.TrimSuffix(strings.TrimPrefix(name, logType.String()+"_"), logExtension)
+	start, err := time.ParseInLocation(logFileTimeLayout, name, loc)
+	if err != nil {
+		return time.Time{}
+	}
+	return start
+}
+
+// parseZoneOffset returns the fixed time zone of a numeric offset, e.g. the +0200 output of date +%z.
+func parseZoneOffset(offset string) (*time.Location, error) {
+	offset = strings.TrimSpace(offset)
+	t, err := time.Parse("-0700", offset)
+	if err != nil {
+		return nil, errors.Errorf("invalid time zone offset %s", offset)
+	}
+	_, seconds := t.Zone()
+	return time.FixedZone(offset, seconds), nil
+}
+
+// ParseLogTime parses a log time filter, either a duration before now (e.g. 30m or 2h)
+// or a date and time. The times without a time zone are UTC.
+func ParseLogTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, layout := range logTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid log time %s, use a duration or a date like %s", value, time.DateTime)
+}
@@ -0,0 +1,168 @@
+package ssh
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastLines(t *testing.T) {
+	lines := make([]string, 0, 10000)
+	for i := range 10000 {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	content := strings.Join(lines, "\n") + "\n"
+
+	tests := []struct {
+		name    string
+		content string
+		n       int
+		want    []string
+	}{
+		{
+			name:    "last lines across blocks",
+			content: content,
+			n:       5000,
+			want:    lines[5000:],
+		},
+		{
+			name:    "last line",
+			content: content,
+			n:       1,
+			want:    []string{"line 9999"},
+		},
+		{
+			name:    "all lines",
+			content: content,
+			n:       0,
+			want:    lines,
+		},
+		{
+			name:    "more lines than the file",
+			content: "a\nb",
+			n:       10,
+			want:    []string{"a", "b"},
+		},
+		{
+			name:    "empty file",
+			content: "",
+			n:       10,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lastLines(strings.NewReader(tt.content), int64(len(tt.content)), tt.n)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLogClock(t *testing.T) {
+	start := time.Date(2024, 5, 10, 23, 58, 30, 0, time.UTC)
+	clock := logClock{current: start}
+
+	// a line without timestamp gets the file creation time.
+	require.Equal(t, start, clock.update("starting node"))
+	// console logger time of the day, with terminal colors.
+	require.Equal(t,
+		time.Date(2024, 5, 10, 23, 58, 0, 0, time.UTC),
+		clock.update("\x1b[90m11:58PM\x1b[0m \x1b[32mINF\x1b[0m committed state height=10"),
+	)
+	// the day changes after midnight.
+	require.Equal(t,
+		time.Date(2024, 5, 11, 0, 1, 0, 0, time.UTC),
+		clock.update("12:01AM INF committed state height=11"),
+	)
+	// JSON logger timestamp.
+	require.Equal(t,
+		time.Date(2024, 5, 11, 8, 0, 0, 0, time.FixedZone("", 2*3600)),
+		clock.update(`{"level":"info","time":"2024-05-11T08:00:00+02:00","message":"committed"}`),
+	)
+	require.Equal(t,
+		time.Date(2024, 5, 11, 10, 30, 15, 0, time.UTC),
+		clock.update("2024-05-11 10:30:15 faucet started"),
+	)
+}
+
+func TestLogQuery(t *testing.T) {
+	var (
+		since = time.Date(2024, 5, 10, 10, 0, 0, 0, time.UTC)
+		until = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+		query = newLogQuery(
+			WithLogSince(since),
+			WithLogUntil(until),
+			WithLogGrep(regexp.MustCompile(`height=\d+`)),
+		)
+	)
+	require.True(t, query.filtered())
+	require.True(t, query.matchTime(since))
+	require.True(t, query.matchTime(time.Time{}))
+	require.False(t, query.matchTime(until.Add(time.Second)))
+	require.True(t, query.matchText("\x1b[32mINF\x1b[0m committed height=10"))
+	require.False(t, query.matchText("INF starting node"))
+	require.False(t, query.matchFile(since.Add(-2*time.Hour), since.Add(-time.Hour)))
+	require.False(t, query.matchFile(until.Add(time.Hour), until.Add(2*time.Hour)))
+	require.True(t, query.matchFile(time.Time{}, until.Add(2*time.Hour)))
+	require.False(t, newLogQuery().filtered())
+}
+
+func TestParseLogTime(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "2h", want: now.Add(-2 * time.Hour)},
+		{value: "2024-05-09 08:30:00", want: time.Date(2024, 5, 9, 8, 30, 0, 0, time.UTC)},
+		{value: "2024-05-09T08:30:00Z", want: time.Date(2024, 5, 9, 8, 30, 0, 0, time.UTC)},
+		{value: "2024-05-09", want: time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC)},
+		{value: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseLogTime(tt.value, now)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestLogFileStart(t *testing.T) {
+	require.Equal(t,
+		time.Date(2024, 5, 10, 9, 15, 30, 0, time.UTC),
+		logFileStart("chain_2024-05-10_09-15-30.log", LogChain, time.UTC),
+	)
+	require.True(t, logFileStart("chain.log", LogChain, time.UTC).IsZero())
+
+	// the file names are in the remote server time zone
+	loc := time.FixedZone("+0200", 2*60*60)
+	require.True(t,
+		time.Date(2024, 5, 10, 7, 15, 30, 0, time.UTC).Equal(logFileStart("chain_2024-05-10_09-15-30.log", LogChain, loc)),
+	)
+}
+
+func TestParseZoneOffset(t *testing.T) {
+	loc, err := parseZoneOffset("+0200\n")
+	require.NoError(t, err)
+	_, offset := time.Date(2024, 5, 10, 0, 0, 0, 0, loc).Zone()
+	require.Equal(t, 2*60*60, offset)
+
+	loc, err = parseZoneOffset("-0530")
+	require.NoError(t, err)
+	_, offset = time.Date(2024, 5, 10, 0, 0, 0, 0, loc).Zone()
+	require.Equal(t, -(5*60*60 + 30*60), offset)
+
+	_, err = parseZoneOffset("UTC")
+	require.Error(t, err)
+}
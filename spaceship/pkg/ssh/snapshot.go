@@ -0,0 +1,196 @@
+package ssh
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	snapshotExtension = ".tar.gz"
+
+	// snapshotTimeLayout is the time layout of the default snapshot names.
+	snapshotTimeLayout = "2006-01-02_15-04-05"
+)
+
+// Snapshot represents an archive of the chain state stored on the remote server.
+type Snapshot struct {
+	Name string
+	Size int64
+	Time time.Time
+}
+
+// SnapshotFolders returns the home folders archived by a snapshot.
+func SnapshotFolders() []string {
+	return []string{"config", "data"}
+}
+
+// Snapshots returns the snapshot directory within the workspace.
+func (s *SSH) Snapshots() string {
+	return filepath.Join(s.Workspace(), "snapshots")
+}
+
+// SnapshotPath returns the remote path of the snapshot archive.
+func (s *SSH) SnapshotPath(name string) string {
+	return filepath.Join(s.Snapshots(), name+snapshotExtension)
+}
+
+// CreateSnapshot archives the config and data folders of the home directory into the
+// snapshot directory. The chain must be stopped to archive a consistent state. If the name
+// is empty, the snapshot is named after the current time.
+func (s *SSH) CreateSnapshot(ctx context.Context, name string) (Snapshot, error) {
+	if name == "" {
+		name = time.Now().UTC().Format(snapshotTimeLayout)
+	}
+	if err := validateSnapshotName(name); err != nil {
+		return Snapshot{}, err
+	}
+	path := s.SnapshotPath(name)
+	if s.FileExist(ctx, path) {
+		return Snapshot{}, errors.Errorf("snapshot %s already exists", name)
+	}
+	if err := s.sftpClient.MkdirAll(s.Snapshots()); err != nil {
+		return Snapshot{}, errors.Wrapf(err, "failed to create snapshot dir %s", s.Snapshots())
+	}
+
+	args := append([]string{"-czf", path, "-C", s.Home()}, SnapshotFolders()...)
+	if _, err := s.RunCommand(ctx, "tar", args...); err != nil {
+		_ = s.sftpClient.Remove(path)
+		return Snapshot{}, errors.Wrapf(err, "failed to create snapshot %s", name)
+	}
+	return s.snapshot(name)
+}
+
+// ListSnapshots returns the snapshots stored on the remote server, sorted by creation time.
+func (s *SSH) ListSnapshots() ([]Snapshot, error) {
+	files, err := s.sftpClient.ReadDir(s.Snapshots())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]Snapshot, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), snapshotExtension) {
+			continue
+		}
+		snapshots = append(snapshots, Snapshot{
+			Name: strings.TrimSuffix(file.Name(), snapshotExtension),
+			Size: file.Size(),
+			Time: file.ModTime(),
+		})
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+	return snapshots, nil
+}
+
+// DownloadSnapshot downloads the snapshot archive to the local destination path.
+func (s *SSH) DownloadSnapshot(name, dstPath string) error {
+	if err := validateSnapshotName(name); err != nil {
+		return err
+	}
+	return s.DownloadFile(s.SnapshotPath(name), dstPath)
+}
+
+// RemoveSnapshot removes the snapshot archive from the remote server.
+func (s *SSH) RemoveSnapshot(name string) error {
+	if err := validateSnapshotName(name); err != nil {
+		return err
+	}
+	if err := s.sftpClient.Remove(s.SnapshotPath(name)); err != nil {
+		return errors.Wrapf(err, "failed to remove snapshot %s", name)
+	}
+	return nil
+}
+
+// restoreDir returns the workspace directory the restored state is uploaded to.
+func (s *SSH) restoreDir() string {
+	return filepath.Join(s.Workspace(), "restore")
+}
+
+// UploadState uploads the config and data folders of the local home into a staging
+// directory, so the home state is only replaced by RestoreState once the upload is complete.
+func (s *SSH) UploadState(ctx context.Context, srcPath string, progressCallback ProgressCallback) error {
+	if _, err := s.RunCommand(ctx, "rm", "-rf", s.restoreDir()); err != nil {
+		return err
+	}
+	_, err := s.Upload(ctx, srcPath, s.restoreDir(), progressCallback)
+	return err
+}
+
+// ExtractSnapshot extracts the config and data folders of the stored snapshot into the
+// staging directory, like UploadState, without transferring the snapshot.
+func (s *SSH) ExtractSnapshot(ctx context.Context, name string) error {
+	if err := validateSnapshotName(name); err != nil {
+		return err
+	}
+	if _, err := s.snapshot(name); err != nil {
+		return err
+	}
+	if _, err := s.RunCommand(ctx, "rm", "-rf", s.restoreDir()); err != nil {
+		return err
+	}
+	if err := s.sftpClient.MkdirAll(s.restoreDir()); err != nil {
+		return errors.Wrapf(err, "failed to create restore dir %s", s.restoreDir())
+	}
+
+	args := append([]string{"-xzf", s.SnapshotPath(name), "-C", s.restoreDir()}, SnapshotFolders()...)
+	if _, err := s.RunCommand(ctx, "tar", args...); err != nil {
+		return errors.Wrapf(err, "failed to extract snapshot %s", name)
+	}
+	return nil
+}
+
+// RestoreState replaces the config and data folders of the home directory by the ones
+// staged by UploadState or ExtractSnapshot, keeping the other home files such as the cosmovisor binaries.
+// The chain must be stopped.
+func (s *SSH) RestoreState(ctx context.Context) error {
+	var (
+		remove = []string{"-rf"}
+		move   = make([]string, 0)
+	)
+	for _, folder := range SnapshotFolders() {
+		// empty folders are not uploaded, create them to always replace the home ones.
+		staged := filepath.Join(s.restoreDir(), folder)
+		if err := s.sftpClient.MkdirAll(staged); err != nil {
+			return errors.Wrapf(err, "failed to create restore dir %s", staged)
+		}
+		remove = append(remove, filepath.Join(s.Home(), folder))
+		move = append(move, staged)
+	}
+
+	if _, err := s.RunCommand(ctx, "rm", remove...); err != nil {
+		return err
+	}
+	if _, err := s.RunCommand(ctx, "mv", append(move, s.Home())...); err != nil {
+		return err
+	}
+	_, err := s.RunCommand(ctx, "rm", "-rf", s.restoreDir())
+	return err
+}
+
+// snapshot returns the stored snapshot.
+func (s *SSH) snapshot(name string) (Snapshot, error) {
+	info, err := s.sftpClient.Stat(s.SnapshotPath(name))
+	if err != nil {
+		return Snapshot{}, errors.Wrapf(err, "snapshot %s not found", name)
+	}
+	return Snapshot{Name: name, Size: info.Size(), Time: info.ModTime()}, nil
+}
+
+// validateSnapshotName checks the snapshot name can be used as a file name.
+func validateSnapshotName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\ '`) {
+		return errors.Errorf("invalid snapshot name %q", name)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/randstr"
@@ -34,6 +35,8 @@ type SSH struct {
 	workspace    string
 	client       *goph.Client
 	sftpClient   *sftp.Client
+	transfers    TransferSummary
+	transfersMu  sync.Mutex
 }
 
 // Option configures SSH settings.
@@ -209,6 +212,11 @@ func (s *SSH) ensureEnvironment() error {
 	return nil
 }
 
+// Host returns the remote server host, without the user and port.
+func (s *SSH) Host() string {
+	return s.host
+}
+
 // Bin returns the binary directory within the workspace.
 func (s *SSH) Bin() string {
 	return filepath.Join(s.Workspace(), "bin")
@@ -0,0 +1,164 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// systemdUnitDir is the systemd user units directory, relative to the user home.
+	systemdUnitDir = ".config/systemd/user"
+
+	// systemctlUser runs systemctl for the user manager, the runtime dir is not always
+	// set for non-interactive SSH sessions.
+	systemctlUser = `XDG_RUNTIME_DIR="/run/user/$(id -u)" systemctl --user`
+
+	// journalctlUser runs journalctl for the user units.
+	journalctlUser = `XDG_RUNTIME_DIR="/run/user/$(id -u)" journalctl --user --no-pager --output cat`
+)
+
+// ChainUnit returns the chain systemd unit name.
+func (s *SSH) ChainUnit() string {
+	return fmt.Sprintf("spaceship-%s.service", s.workspace)
+}
+
+// FaucetUnit returns the faucet systemd unit name.
+func (s *SSH) FaucetUnit() string {
+	return fmt.Sprintf("spaceship-%s-faucet.service", s.workspace)
+}
+
+// chainUnitPath returns the path to the chain systemd unit file.
+func (s *SSH) chainUnitPath() string {
+	return filepath.Join(systemdUnitDir, s.ChainUnit())
+}
+
+// faucetUnitPath returns the path to the faucet systemd unit file.
+func (s *SSH) faucetUnitPath() string {
+	return filepath.Join(systemdUnitDir, s.FaucetUnit())
+}
+
+// faucetEnv returns the path to the faucet systemd unit environment file.
+func (s *SSH) faucetEnv() string {
+	return filepath.Join(s.Workspace(), "faucet.env")
+}
+
+// HasSystemd checks if the chain is managed by systemd on the remote server.
+func (s *SSH) HasSystemd(ctx context.Context) bool {
+	return s.FileExist(ctx, s.chainUnitPath())
+}
+
+// InstallSystemd uploads the chain.service and faucet.service units from the source directory
+// to the remote user units and reloads the systemd user manager.
+func (s *SSH) InstallSystemd(ctx context.Context, srcPath string, progressCallback ProgressCallback) error {
+	if _, err := s.UploadFile(ctx, filepath.Join(srcPath, "chain.service"), s.chainUnitPath(), progressCallback); err != nil {
+		return err
+	}
+	if _, err := s.UploadFile(ctx, filepath.Join(srcPath, "faucet.service"), s.faucetUnitPath(), progressCallback); err != nil {
+		return err
+	}
+	_, err := s.systemctl(ctx, "daemon-reload")
+	return err
+}
+
+// UninstallSystemd stops, disables and removes the chain and faucet systemd units.
+func (s *SSH) UninstallSystemd(ctx context.Context) error {
+	for _, unit := range []string{s.ChainUnit(), s.FaucetUnit()} {
+		if _, err := s.systemctl(ctx, "disable", "--now", unit); err != nil {
+			return err
+		}
+	}
+	if _, err := s.RunCommand(ctx, "rm", "-f", s.chainUnitPath(), s.faucetUnitPath()); err != nil {
+		return err
+	}
+	_, err := s.systemctl(ctx, "daemon-reload")
+	return err
+}
+
+// EnableLinger enables the user lingering, so the user units are started at boot
+// and keep running after the SSH session is closed.
+func (s *SSH) EnableLinger(ctx context.Context) error {
+	_, err := s.RunCommand(ctx, "loginctl", "enable-linger", s.username)
+	return err
+}
+
+// startUnit enables and starts a systemd unit.
+func (s *SSH) startUnit(ctx context.Context, unit string) (string, error) {
+	if _, err := s.systemctl(ctx, "enable", "--now", unit); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s started.", unit), nil
+}
+
+// restartUnit enables and restarts a systemd unit.
+func (s *SSH) restartUnit(ctx context.Context, unit string) (string, error) {
+	if _, err := s.systemctl(ctx, "enable", unit); err != nil {
+		return "", err
+	}
+	if _, err := s.systemctl(ctx, "restart", unit); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s restarted.", unit), nil
+}
+
+// stopUnit stops and disables a systemd unit, so it is not started at boot.
+func (s *SSH) stopUnit(ctx context.Context, unit string) (string, error) {
+	if _, err := s.systemctl(ctx, "disable", "--now", unit); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s stopped.", unit), nil
+}
+
+// unitStatus returns the systemd unit state, main PID and number of restarts.
+func (s *SSH) unitStatus(ctx context.Context, unit string) (string, error) {
+	output, err := s.systemctl(
+		ctx,
+		"show",
+		unit,
+		"--property", "ActiveState",
+		"--property", "SubState",
+		"--property", "MainPID",
+		"--property", "NRestarts",
+	)
+	if err != nil {
+		return "", err
+	}
+
+	props := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if ok {
+			props[key] = value
+		}
+	}
+
+	status := fmt.Sprintf("%s is %s (%s)", unit, props["ActiveState"], props["SubState"])
+	if pid, _ := strconv.Atoi(props["MainPID"]); pid > 0 {
+		status = fmt.Sprintf("%s with PID %d", status, pid)
+	}
+	if restarts, _ := strconv.Atoi(props["NRestarts"]); restarts > 0 {
+		status = fmt.Sprintf("%s, restarted %d times", status, restarts)
+	}
+	return status + ".", nil
+}
+
+// writeFaucetEnv writes the faucet unit environment file with the faucet port.
+func (s *SSH) writeFaucetEnv(port uint64) error {
+	file, err := s.sftpClient.Create(s.faucetEnv())
+	if err != nil {
+		return errors.Wrapf(err, "failed to create the faucet env file %s", s.faucetEnv())
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "PORT=%d\n", port)
+	return err
+}
+
+// systemctl runs systemctl for the user manager on the remote server.
+func (s *SSH) systemctl(ctx context.Context, args ...string) (string, error) {
+	return s.RunCommand(ctx, systemctlUser, args...)
+}
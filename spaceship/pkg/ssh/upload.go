@@ -1,18 +1,29 @@
 package ssh
 
 import (
+	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 
+	"github.com/dustin/go-humanize"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/apps/spaceship/pkg/faucet"
 )
 
+// partialSuffix is the suffix of the remote file being uploaded, renamed to the destination
+// file once complete, so an interrupted upload can be resumed by the next one.
+const partialSuffix = ".partial"
+
 // ProgressCallback is a type for the callback function to update the progress.
 type ProgressCallback func(uploaded int64, total int64) error
 
@@ -29,12 +40,56 @@ func (pw *progressWriter) Write(p []byte) (int, error) {
 	return n, pw.progressCallback(pw.bytesUploaded, pw.totalBytes)
 }
 
+// TransferSummary represents the files uploaded and skipped by the SSH client.
+type TransferSummary struct {
+	// Uploaded is the list of uploaded remote files, including the resumed ones.
+	Uploaded []string
+	// Resumed is the list of remote files resumed from a previous interrupted upload.
+	Resumed []string
+	// Skipped is the list of remote files skipped because they have not changed.
+	Skipped []string
+	// UploadedBytes is the number of bytes sent.
+	UploadedBytes int64
+	// SkippedBytes is the size of the skipped files.
+	SkippedBytes int64
+}
+
+// String returns a human-readable summary of the transfers.
+func (t TransferSummary) String() string {
+	summary := fmt.Sprintf("Uploaded %d files (%s)", len(t.Uploaded), humanize.Bytes(uint64(t.UploadedBytes)))
+	if len(t.Resumed) > 0 {
+		summary += fmt.Sprintf(", %d resumed", len(t.Resumed))
+	}
+	return summary + fmt.Sprintf(
+		", skipped %d unchanged files (%s)",
+		len(t.Skipped),
+		humanize.Bytes(uint64(t.SkippedBytes)),
+	)
+}
+
+// Transfers returns the summary of the files uploaded and skipped since the client was created.
+func (s *SSH) Transfers() TransferSummary {
+	s.transfersMu.Lock()
+	defer s.transfersMu.Unlock()
+	return TransferSummary{
+		Uploaded:      slices.Clone(s.transfers.Uploaded),
+		Resumed:       slices.Clone(s.transfers.Resumed),
+		Skipped:       slices.Clone(s.transfers.Skipped),
+		UploadedBytes: s.transfers.UploadedBytes,
+		SkippedBytes:  s.transfers.SkippedBytes,
+	}
+}
+
 // Upload uploads a directory recursively to the remote server with a progress callback.
+// Files with the same SHA-256 hash as the remote ones are skipped, and only the uploaded
+// files are returned.
 func (s *SSH) Upload(ctx context.Context, srcPath, dstPath string, progressCallback ProgressCallback) ([]string, error) {
 	var (
 		totalFiles    int64
 		totalBytes    int64
 		uploadedBytes int64
+		dstFiles      []string
+		mu            sync.Mutex
 	)
 
 	// Count the total number of files and total bytes to be uploaded
@@ -50,6 +105,7 @@ func (s *SSH) Upload(ctx context.Context, srcPath, dstPath string, progressCallb
 			if !strings.HasPrefix(rel, ".") {
 				totalFiles++
 				totalBytes += info.Size()
+				dstFiles = append(dstFiles, filepath.Join(dstPath, rel))
 			}
 		}
 		return nil
@@ -58,7 +114,13 @@ func (s *SSH) Upload(ctx context.Context, srcPath, dstPath string, progressCallb
 		return nil, err
 	}
 
-	grp, _ := errgroup.WithContext(ctx)
+	// hash all the remote files at once instead of one command per file.
+	remoteHashes, err := s.remoteHashes(ctx, dstFiles...)
+	if err != nil {
+		return nil, err
+	}
+
+	grp, ctx := errgroup.WithContext(ctx)
 	grp.SetLimit(5)
 
 	uploadedFiles := make([]string, 0)
@@ -79,15 +141,24 @@ func (s *SSH) Upload(ctx context.Context, srcPath, dstPath string, progressCallb
 			newPath := filepath.Join(dstPath, rel)
 
 			grp.Go(func() error {
-				file, err := s.UploadFile(path, newPath, func(bytesUploaded int64, _ int64) error {
-					uploadedBytes += bytesUploaded
+				// the callback reports the bytes of this file, add them to the shared total.
+				var fileBytes int64
+				uploaded, err := s.uploadFile(ctx, path, newPath, remoteHashes[newPath], func(bytesUploaded int64, _ int64) error {
+					mu.Lock()
+					defer mu.Unlock()
+					uploadedBytes += bytesUploaded - fileBytes
+					fileBytes = bytesUploaded
 					// Call the progress callback with the total uploaded bytes
 					return progressCallback(uploadedBytes, totalBytes)
 				})
 				if err != nil {
 					return err
 				}
-				uploadedFiles = append(uploadedFiles, file)
+				if uploaded {
+					mu.Lock()
+					uploadedFiles = append(uploadedFiles, newPath)
+					mu.Unlock()
+				}
 				return nil
 			})
 		}
@@ -96,58 +167,207 @@ func (s *SSH) Upload(ctx context.Context, srcPath, dstPath string, progressCallb
 	if err != nil {
 		return nil, err
 	}
-	return uploadedFiles, grp.Wait()
+	if err := grp.Wait(); err != nil {
+		return nil, err
+	}
+	slices.Sort(uploadedFiles)
+	return uploadedFiles, nil
 }
 
 // UploadFile uploads a single file to the remote server with progress tracking.
-func (s *SSH) UploadFile(filePath, dstPath string, progressCallback ProgressCallback) (string, error) {
+// The upload is skipped if the remote file has the same SHA-256 hash.
+func (s *SSH) UploadFile(ctx context.Context, filePath, dstPath string, progressCallback ProgressCallback) (string, error) {
+	remoteHashes, err := s.remoteHashes(ctx, dstPath)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.uploadFile(ctx, filePath, dstPath, remoteHashes[dstPath], progressCallback); err != nil {
+		return "", err
+	}
+	return dstPath, nil
+}
+
+// uploadFile uploads a file to the remote server unless the remote hash matches the local one.
+// The file is written into a partial remote file, resumed if it is a prefix of the local file,
+// and renamed to the destination path once complete. It returns false if the upload was skipped.
+func (s *SSH) uploadFile(
+	ctx context.Context,
+	filePath,
+	dstPath,
+	remoteHash string,
+	progressCallback ProgressCallback,
+) (bool, error) {
 	dstDir := filepath.Dir(dstPath)
 	if err := s.sftpClient.MkdirAll(dstDir); err != nil {
-		return "", errors.Wrapf(err, "failed to create destination path %s", dstDir)
+		return false, errors.Wrapf(err, "failed to create destination path %s", dstDir)
 	}
 
 	srcPath, err := filepath.Abs(filePath)
 	if err != nil {
-		return "", err
+		return false, err
 	}
 
 	srcFile, err := os.Open(srcPath)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to open source file %s", srcPath)
+		return false, errors.Wrapf(err, "failed to open source file %s", srcPath)
 	}
 	defer srcFile.Close()
 
 	fileInfo, err := srcFile.Stat()
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to get file info for %s", srcPath)
+		return false, errors.Wrapf(err, "failed to get file info for %s", srcPath)
 	}
 	totalBytes := fileInfo.Size()
+
+	localHash, err := fileHash(srcFile, totalBytes)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to hash file %s", srcPath)
+	}
+	if localHash == remoteHash {
+		s.recordTransfer(dstPath, false, false, totalBytes)
+		return false, progressCallback(totalBytes, totalBytes)
+	}
+
+	partialPath := dstPath + partialSuffix
+	offset, err := s.resumeOffset(ctx, srcFile, partialPath, totalBytes)
+	if err != nil {
+		return false, err
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if offset > 0 {
+		flags = os.O_WRONLY | os.O_APPEND
+	}
+	dstFile, err := s.sftpClient.OpenFile(partialPath, flags)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create destination file %s", partialPath)
+	}
+	defer dstFile.Close()
+
+	if _, err := srcFile.Seek(offset, io.SeekStart); err != nil {
+		return false, err
+	}
 	srcReader := io.TeeReader(srcFile, &progressWriter{
+		bytesUploaded:    offset,
 		totalBytes:       totalBytes,
 		progressCallback: progressCallback,
 	})
+	if err := progressCallback(offset, totalBytes); err != nil {
+		return false, err
+	}
+
+	if _, err := io.Copy(dstFile, srcReader); err != nil {
+		return false, errors.Wrapf(err, "failed to upload file %s to %s", srcPath, dstPath)
+	}
+	if err := dstFile.Close(); err != nil {
+		return false, errors.Wrapf(err, "failed to upload file %s to %s", srcPath, dstPath)
+	}
+
+	// replacing the file with a rename also works for the binaries currently running.
+	if err := s.sftpClient.PosixRename(partialPath, dstPath); err != nil {
+		return false, errors.Wrapf(err, "failed to move %s to %s", partialPath, dstPath)
+	}
+	if err := s.sftpClient.Chmod(dstPath, fileInfo.Mode().Perm()); err != nil {
+		return false, errors.Wrapf(err, "failed to set the %s file mode", dstPath)
+	}
+	s.recordTransfer(dstPath, true, offset > 0, totalBytes-offset)
+	return true, nil
+}
 
-	dstFile, err := s.sftpClient.Create(dstPath)
+// resumeOffset returns the size of the remote partial file if it is a prefix of the
+// local file, or zero if the upload must start from the beginning.
+func (s *SSH) resumeOffset(ctx context.Context, srcFile *os.File, partialPath string, totalBytes int64) (int64, error) {
+	info, err := s.sftpClient.Stat(partialPath)
+	if err != nil || info.Size() == 0 || info.Size() >= totalBytes {
+		return 0, nil
+	}
+
+	remoteHashes, err := s.remoteHashes(ctx, partialPath)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to create destination file %s", dstPath)
+		return 0, err
+	}
+	if _, err := srcFile.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	prefixHash, err := fileHash(srcFile, info.Size())
+	if err != nil {
+		return 0, err
+	}
+	if remoteHashes[partialPath] != prefixHash {
+		return 0, nil
+	}
+	return info.Size(), nil
+}
+
+// remoteHashes returns the SHA-256 hashes of the remote files. Missing files are not part of
+// the result, and the result is empty if the remote server cannot compute the hashes.
+func (s *SSH) remoteHashes(ctx context.Context, paths ...string) (map[string]string, error) {
+	hashes := make(map[string]string)
+	if len(paths) == 0 {
+		return hashes, nil
 	}
-	defer dstFile.Close()
 
-	_, err = io.Copy(dstFile, srcReader)
+	files := make([]string, 0, len(paths))
+	for _, path := range paths {
+		files = append(files, shellQuote(path))
+	}
+	// sha256sum fails if a file is missing, but still prints the hashes of the others.
+	output, err := s.RunCommand(ctx, fmt.Sprintf("sha256sum -- %s 2>/dev/null; true", strings.Join(files, " ")))
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to upload file %s to %s", srcPath, dstPath)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return hashes, nil
 	}
-	return dstPath, nil
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		// sha256sum escapes the names with special characters, ignore them.
+		hash, file, ok := strings.Cut(scanner.Text(), "  ")
+		if !ok || strings.HasPrefix(hash, "\\") {
+			continue
+		}
+		hashes[file] = hash
+	}
+	return hashes, scanner.Err()
+}
+
+// recordTransfer adds an uploaded or skipped file to the transfer summary.
+func (s *SSH) recordTransfer(path string, uploaded, resumed bool, bytes int64) {
+	s.transfersMu.Lock()
+	defer s.transfersMu.Unlock()
+	if !uploaded {
+		s.transfers.Skipped = append(s.transfers.Skipped, path)
+		s.transfers.SkippedBytes += bytes
+		return
+	}
+	s.transfers.Uploaded = append(s.transfers.Uploaded, path)
+	s.transfers.UploadedBytes += bytes
+	if resumed {
+		s.transfers.Resumed = append(s.transfers.Resumed, path)
+	}
+}
+
+// fileHash returns the hex encoded SHA-256 hash of the first bytes of a file.
+func fileHash(file *os.File, size int64) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	if _, err := io.CopyN(hash, file, size); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
 // UploadBinary uploads a binary file to the remote server's Bin directory
 // and sets the appropriate permissions.
-func (s *SSH) UploadBinary(srcPath string, progressCallback ProgressCallback) (string, error) {
+func (s *SSH) UploadBinary(ctx context.Context, srcPath string, progressCallback ProgressCallback) (string, error) {
 	var (
 		filename = filepath.Base(srcPath)
 		binPath  = filepath.Join(s.Bin(), filename)
 	)
-	if _, err := s.UploadFile(srcPath, binPath, progressCallback); err != nil {
+	if _, err := s.UploadFile(ctx, srcPath, binPath, progressCallback); err != nil {
 		return "", err
 	}
 
@@ -187,5 +407,10 @@ func (s *SSH) UploadFaucetBinary(ctx context.Context, target string, progressCal
 	if err != nil {
 		return "", err
 	}
-	return s.UploadBinary(bin, progressCallback)
+	return s.UploadBinary(ctx, bin, progressCallback)
+}
+
+// shellQuote quotes the value to be passed as a single shell argument.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
 }
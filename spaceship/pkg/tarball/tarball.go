@@ -62,7 +62,8 @@ func ExtractData(ctx context.Context, file io.Reader, output string, fileList ..
 			return err
 		}
 
-		newFile, err := os.OpenFile(newFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		// keep the archived file modes, e.g. the private keys must stay readable by the owner only.
+		newFile, err := os.OpenFile(newFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, f.Mode().Perm())
 		if err != nil {
 			return err
 		}
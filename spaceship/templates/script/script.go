@@ -16,7 +16,7 @@ import (
 var fsRunScript embed.FS
 
 // NewRunScripts returns the generator to scaffold a chain and faucet run script.
-func NewRunScripts(path, log, home, binDirPath, chainBinPath, faucetBinPath, account, denoms, output string) error {
+func NewRunScripts(path, log, home, binDirPath, chainBinPath, faucetBinPath, cosmovisorBinPath, account, denoms, output string) error {
 	runScript, err := fs.Sub(fsRunScript, "files")
 	if err != nil {
 		return err
@@ -33,6 +33,7 @@ func NewRunScripts(path, log, home, binDirPath, chainBinPath, faucetBinPath, acc
 	ctx.Set("home", home)
 	ctx.Set("chainBinPath", chainBinPath)
 	ctx.Set("faucetBinPath", faucetBinPath)
+	ctx.Set("cosmovisorBinPath", cosmovisorBinPath)
 	ctx.Set("binDirPath", binDirPath)
 	ctx.Set("binary", filepath.Base(chainBinPath))
 	ctx.Set("account", account)
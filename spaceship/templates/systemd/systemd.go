@@ -0,0 +1,47 @@
+package systemd
+
+import (
+	"embed"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+)
+
+//go:embed files/*
+var fsUnits embed.FS
+
+// NewUnits returns the generator to scaffold the chain and faucet systemd user units.
+func NewUnits(path, home, binDirPath, chainBinPath, faucetBinPath, cosmovisorBinPath, chainUnit, account, denoms, output string) error {
+	units, err := fs.Sub(fsUnits, "files")
+	if err != nil {
+		return err
+	}
+
+	g := genny.New()
+	if err := g.OnlyFS(units, nil, nil); err != nil {
+		return errors.Errorf("generator fs: %w", err)
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("path", path)
+	ctx.Set("home", home)
+	ctx.Set("chainBinPath", chainBinPath)
+	ctx.Set("faucetBinPath", faucetBinPath)
+	ctx.Set("cosmovisorBinPath", cosmovisorBinPath)
+	ctx.Set("binDirPath", binDirPath)
+	ctx.Set("binary", filepath.Base(chainBinPath))
+	ctx.Set("chainUnit", chainUnit)
+	ctx.Set("account", account)
+	ctx.Set("denoms", denoms)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+
+	_, err = xgenny.NewRunner(ctx, output).RunAndApply(g)
+	return err
+}